@@ -0,0 +1,571 @@
+package loggercheck
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/cfg"
+
+	"github.com/timonwong/loggercheck/internal/checkers"
+	"github.com/timonwong/loggercheck/internal/stringutil"
+)
+
+// boundKeysFact records the keys bound to a logger stored in a struct field,
+// for example: `s.log = logger.WithValues("component", "db")`.
+type boundKeysFact struct {
+	Keys []string
+}
+
+func (*boundKeysFact) AFact() {}
+
+func (f *boundKeysFact) String() string {
+	return "boundKeys(" + strings.Join(f.Keys, ",") + ")"
+}
+
+// fieldBindings maps logger fields to the keys bound to them.
+type fieldBindings map[*types.Var][]checkers.Key
+
+// maxFieldRounds bounds the rounds collecting the keys bound to fields, fields assigned from each other
+// may not converge.
+const maxFieldRounds = 8
+
+// collectFieldBindings collects keys bound to loggers stored in struct fields, including fields declared
+// in dependencies.
+func (l *loggercheck) collectFieldBindings(pass *analysis.Pass) fieldBindings {
+	// Fields declared in dependencies, positions of the keys are unknown.
+	deps := make(fieldBindings)
+	for _, objFact := range pass.AllObjectFacts() {
+		field, ok := objFact.Object.(*types.Var)
+		fact, isBoundKeys := objFact.Fact.(*boundKeysFact)
+		if !ok || !isBoundKeys || field.Pkg() == pass.Pkg {
+			continue
+		}
+
+		keys := make([]checkers.Key, 0, len(fact.Keys))
+		for _, name := range fact.Keys {
+			keys = append(keys, checkers.Key{Name: name})
+		}
+		deps[field] = keys
+	}
+
+	// Fields may be assigned after they are used in the source, and from other fields: each round collects the
+	// assignments of the package with the bindings of the previous round, until the bindings are stable.
+	// They do not depend on the order of the declarations then.
+	fields := deps
+	for round := 0; round < maxFieldRounds; round++ {
+		c := &boundKeysChecker{
+			l:        l,
+			pass:     pass,
+			fields:   fields,
+			assigned: make(fieldBindings),
+		}
+		c.walk()
+		for field, keys := range deps {
+			c.assigned[field] = keys
+		}
+
+		stable := sameBindings(c.assigned, fields)
+		fields = c.assigned
+		if stable {
+			break
+		}
+	}
+
+	exportFieldFacts(pass, fields)
+	return fields
+}
+
+// boundKeysChecker reports keys of logging calls which are already bound to the logger by a With-like call,
+// for example: `l := logger.With("request_id", id)`.
+// Keys bound to a local variable are only taken into account by the calls the binding dominates, that is the calls
+// the binding is made on every path to. The paths are given by the control flow graph of functions.
+type boundKeysChecker struct {
+	l    *loggercheck
+	pass *analysis.Pass
+
+	fields     fieldBindings // keys bound to logger fields
+	assigned   fieldBindings // keys bound by the assignments of fields, collected if not nil
+	checkCalls bool          // report keys of calls which are already bound
+
+	locals    keyState          // keys bound to local logger variables at the current node
+	final     bool              // set once the keys bound at the entry of the blocks of the function are known
+	rangeVars map[ast.Expr]bool // keys and values of range statements, assigned by the loop
+}
+
+// keyState maps local logger variables to the keys bound to them at a point of a function.
+type keyState map[*types.Var][]checkers.Key
+
+func (s keyState) clone() keyState {
+	clone := make(keyState, len(s))
+	for v, keys := range s {
+		clone[v] = keys
+	}
+	return clone
+}
+
+func (l *loggercheck) checkBoundKeys(pass *analysis.Pass) {
+	c := &boundKeysChecker{
+		l:          l,
+		pass:       pass,
+		fields:     pass.ResultOf[l.facts].(*factsResult).fields,
+		checkCalls: true,
+	}
+	c.walk()
+}
+
+// walk visits the functions and the package level variables of the package.
+func (c *boundKeysChecker) walk() {
+	c.rangeVars = make(map[ast.Expr]bool)
+
+	insp := c.pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+		(*ast.GenDecl)(nil),
+	}
+	insp.Nodes(nodeFilter, func(node ast.Node, push bool) bool {
+		if !push {
+			return false
+		}
+
+		switch node := node.(type) {
+		case *ast.FuncDecl:
+			if node.Body != nil {
+				c.funcBody(node.Body, make(keyState))
+			}
+		case *ast.GenDecl:
+			c.locals, c.final = make(keyState), true
+			for _, spec := range node.Specs {
+				if spec, ok := spec.(*ast.ValueSpec); ok {
+					c.node(spec)
+				}
+			}
+		}
+		return false // declarations in functions are visited with their blocks
+	})
+}
+
+// funcBody visits the body of a function with the keys bound at its entry. The keys bound at the entry of each
+// block are computed first with a worklist, only the keys bound on every path to a block are kept.
+// The blocks are visited with these keys afterwards.
+func (c *boundKeysChecker) funcBody(body *ast.BlockStmt, entry keyState) {
+	ast.Inspect(body, func(node ast.Node) bool {
+		if stmt, ok := node.(*ast.RangeStmt); ok {
+			c.rangeVars[stmt.Key], c.rangeVars[stmt.Value] = true, true
+		}
+		return true
+	})
+
+	g := cfg.New(body, c.mayReturn)
+	final := c.final
+	c.final = false
+
+	in := make([]keyState, len(g.Blocks)) // nil until a path to the block is known
+	in[0] = entry
+	work := []*cfg.Block{g.Blocks[0]}
+	for len(work) > 0 {
+		block := work[len(work)-1]
+		work = work[:len(work)-1]
+
+		out := c.block(block, in[block.Index])
+		for _, succ := range block.Succs {
+			next := out
+			if prev := in[succ.Index]; prev != nil {
+				if next = c.meet(prev, out); sameBindings(next, prev) {
+					continue
+				}
+			}
+			in[succ.Index] = next
+			work = append(work, succ)
+		}
+	}
+
+	c.final = true
+	for _, block := range g.Blocks {
+		if in[block.Index] != nil {
+			c.block(block, in[block.Index])
+		}
+	}
+	c.final = final
+}
+
+// block visits the nodes of the block with the keys bound at its entry, it returns the keys bound at its exit.
+func (c *boundKeysChecker) block(block *cfg.Block, entry keyState) keyState {
+	c.locals = entry.clone()
+	for _, node := range block.Nodes {
+		c.node(node)
+	}
+	return c.locals
+}
+
+// node visits a node of a block: a statement, a variable declaration or an expression.
+func (c *boundKeysChecker) node(node ast.Node) {
+	switch node := node.(type) {
+	case *ast.AssignStmt:
+		// Bindings are updated after the right hand side is checked: `l = l.With("key", v)`.
+		c.exprs(node.Rhs)
+		c.exprs(node.Lhs)
+		for i, lhs := range node.Lhs {
+			if len(node.Lhs) == len(node.Rhs) {
+				c.bind(lhs, node.Rhs[i])
+			} else {
+				c.bind(lhs, nil) // results of a multi-value call
+			}
+		}
+	case *ast.ValueSpec:
+		c.exprs(node.Values)
+		for i, name := range node.Names {
+			if len(node.Names) == len(node.Values) {
+				c.bind(name, node.Values[i])
+			} else {
+				c.bind(name, nil)
+			}
+		}
+	case ast.Expr:
+		c.expr(node)
+		if c.rangeVars[node] {
+			c.bind(node, nil)
+		}
+	default:
+		c.expr(node)
+	}
+}
+
+func (c *boundKeysChecker) exprs(exprs []ast.Expr) {
+	for _, expr := range exprs {
+		c.expr(expr)
+	}
+}
+
+// expr visits the calls of the node and the fields bound by composite literals.
+func (c *boundKeysChecker) expr(node ast.Node) {
+	ast.Inspect(node, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CallExpr:
+			if c.final && c.checkCalls {
+				c.checkCall(node)
+			}
+		case *ast.CompositeLit:
+			for _, elt := range node.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok && c.fieldOf(kv.Key) != nil {
+					c.bind(kv.Key, kv.Value)
+				}
+			}
+		case *ast.FuncLit:
+			c.funcLit(node)
+			return false
+		}
+		return true
+	})
+}
+
+// funcLit visits the body of the function literal with the keys bound where it is declared. The variables
+// it assigns are unknown after the literal, since it may run at any time.
+func (c *boundKeysChecker) funcLit(lit *ast.FuncLit) {
+	locals := c.locals
+	if c.final {
+		c.funcBody(lit.Body, locals)
+	}
+	c.locals = locals
+
+	ast.Inspect(lit.Body, func(node ast.Node) bool {
+		if assign, ok := node.(*ast.AssignStmt); ok {
+			for _, lhs := range assign.Lhs {
+				c.bindLocal(lhs, nil)
+			}
+		}
+		return true
+	})
+}
+
+// mayReturn reports whether the call may return, calls to panic do not.
+func (c *boundKeysChecker) mayReturn(call *ast.CallExpr) bool {
+	ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok {
+		return true
+	}
+	builtin, ok := c.pass.TypesInfo.Uses[ident].(*types.Builtin)
+	return !ok || builtin.Name() != "panic"
+}
+
+// meet returns the keys bound in both states.
+func (c *boundKeysChecker) meet(a, b keyState) keyState {
+	result := make(keyState, len(a))
+	for v, keys := range a {
+		var kept []checkers.Key
+		for _, key := range keys {
+			if _, ok := c.findKey(b[v], key.Name); ok {
+				kept = append(kept, key)
+			}
+		}
+		if len(kept) > 0 {
+			result[v] = kept
+		}
+	}
+	return result
+}
+
+// sameBindings returns true if the same keys are bound to the same variables.
+func sameBindings(a, b map[*types.Var][]checkers.Key) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for v, keys := range a {
+		other, ok := b[v]
+		if !ok || len(keys) != len(other) {
+			return false
+		}
+		for i := range keys {
+			if keys[i].Name != other[i].Name {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (c *boundKeysChecker) bind(lhs, rhs ast.Expr) {
+	if field := c.fieldOf(lhs); field != nil {
+		if c.final && c.assigned != nil {
+			c.bindField(field, rhs)
+		}
+		return
+	}
+	c.bindLocal(lhs, rhs)
+}
+
+func (c *boundKeysChecker) bindLocal(lhs, rhs ast.Expr) {
+	ident, ok := ast.Unparen(lhs).(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	v, ok := c.pass.TypesInfo.ObjectOf(ident).(*types.Var)
+	if !ok || v.IsField() || v.Parent() == nil || v.Parent() == c.pass.Pkg.Scope() {
+		return // only local variables are tracked
+	}
+
+	if keys := c.boundKeysOf(rhs); len(keys) > 0 {
+		c.locals[v] = keys
+	} else {
+		delete(c.locals, v)
+	}
+}
+
+// bindField records keys bound to the field, if the field is assigned several times only keys bound by every
+// assignment are kept. Keys bound again, like in `s.log = s.log.With("key", v)`, are recorded once.
+func (c *boundKeysChecker) bindField(field *types.Var, rhs ast.Expr) {
+	var keys []checkers.Key
+	for _, key := range c.boundKeysOf(rhs) {
+		if _, ok := c.findKey(keys, key.Name); !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	prev, seen := c.assigned[field]
+	if !seen {
+		c.assigned[field] = keys
+		return
+	}
+
+	var kept []checkers.Key
+	for _, key := range prev {
+		if _, ok := c.findKey(keys, key.Name); ok {
+			kept = append(kept, key)
+		}
+	}
+	c.assigned[field] = kept
+}
+
+// exportFieldFacts exports the keys bound to the fields declared in the package.
+func exportFieldFacts(pass *analysis.Pass, fields fieldBindings) {
+	for field, keys := range fields {
+		if len(keys) == 0 || field.Pkg() != pass.Pkg {
+			continue
+		}
+
+		fact := &boundKeysFact{Keys: make([]string, 0, len(keys))}
+		for _, key := range keys {
+			fact.Keys = append(fact.Keys, key.Name)
+		}
+		pass.ExportObjectFact(field, fact)
+	}
+}
+
+// fieldOf returns the struct field declared in the current package the expression refers to.
+func (c *boundKeysChecker) fieldOf(expr ast.Expr) *types.Var {
+	var obj types.Object
+	switch expr := ast.Unparen(expr).(type) {
+	case *ast.SelectorExpr:
+		if sel := c.pass.TypesInfo.Selections[expr]; sel != nil && sel.Kind() == types.FieldVal {
+			obj = sel.Obj()
+		}
+	case *ast.Ident: // key of composite literals
+		obj = c.pass.TypesInfo.ObjectOf(expr)
+	}
+
+	field, ok := obj.(*types.Var)
+	if !ok || !field.IsField() || field.Pkg() != c.pass.Pkg {
+		return nil
+	}
+	return field
+}
+
+// boundKeysOf returns the keys bound to the logger the expression evaluates to.
+func (c *boundKeysChecker) boundKeysOf(expr ast.Expr) []checkers.Key {
+	switch expr := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		if v, ok := c.pass.TypesInfo.Uses[expr].(*types.Var); ok {
+			return c.locals[v]
+		}
+	case *ast.SelectorExpr:
+		sel := c.pass.TypesInfo.Selections[expr]
+		if sel == nil || sel.Kind() != types.FieldVal {
+			return nil
+		}
+
+		return c.fields[sel.Obj().(*types.Var)]
+	case *ast.CallExpr:
+		checker, callCtx, ok := c.l.resolveCall(c.pass, expr)
+		if !ok {
+			return nil
+		}
+		if carried := contextLoggerOf(callCtx); carried != nil {
+			return c.boundKeysOf(carried)
+		}
+		if !isWithLikeFunc(callCtx.Func) {
+			return nil
+		}
+		if callCtx.Func.Name() == "WithGroup" {
+			return nil // keys of the group are qualified by the group name, like slog's (*Logger).WithGroup
+		}
+
+		var keys []checkers.Key
+		if base := loggerBaseOf(callCtx); base != nil {
+			keys = append(keys, c.boundKeysOf(base)...)
+		}
+		keys = append(keys, checkers.LoggingKeys(checker, c.pass, callCtx)...)
+		return keysInNamespace(keys)
+	}
+
+	return nil
+}
+
+func (c *boundKeysChecker) checkCall(call *ast.CallExpr) {
+	checker, callCtx, ok := c.l.resolveCall(c.pass, call)
+	if !ok {
+		return
+	}
+
+	base := loggerBaseOf(callCtx)
+	if base == nil {
+		return
+	}
+
+	bound := c.boundKeysOf(base)
+	if len(bound) == 0 {
+		return
+	}
+
+	for _, key := range keysBeforeNamespace(checkers.LoggingKeys(checker, c.pass, callCtx)) {
+		prev, ok := c.findKey(bound, key.Name)
+		if !ok {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      key.Expr.Pos(),
+			End:      key.Expr.End(),
+			Category: checkers.DiagnosticCategory,
+			Message:  fmt.Sprintf("duplicate logging key %q, it is already bound to the logger", key.Name),
+		}
+		if prev.Expr != nil {
+			diag.Related = []analysis.RelatedInformation{
+				{
+					Pos:     prev.Expr.Pos(),
+					End:     prev.Expr.End(),
+					Message: fmt.Sprintf("key %q bound here", prev.Name),
+				},
+			}
+		}
+		c.pass.Report(diag)
+	}
+}
+
+func (c *boundKeysChecker) findKey(keys []checkers.Key, name string) (checkers.Key, bool) {
+	for _, key := range keys {
+		if key.Name == name || (c.l.foldKeys && stringutil.NormalizeKey(key.Name) == stringutil.NormalizeKey(name)) {
+			return key, true
+		}
+	}
+	return checkers.Key{}, false
+}
+
+// keysInNamespace returns the keys following the last namespace, like zap.Namespace,
+// since keys logged later are nested in it.
+func keysInNamespace(keys []checkers.Key) []checkers.Key {
+	for i := len(keys) - 1; i >= 0; i-- {
+		if keys[i].Namespace {
+			return keys[i+1:]
+		}
+	}
+	return keys
+}
+
+// keysBeforeNamespace returns the keys up to the first namespace, which share the scope of the bound keys.
+func keysBeforeNamespace(keys []checkers.Key) []checkers.Key {
+	for i, key := range keys {
+		if key.Namespace {
+			return keys[:i+1]
+		}
+	}
+	return keys
+}
+
+// isWithLikeFunc returns true if the function derives a new logger with extra key-value pairs,
+// for example: slog.With, (logr.Logger).WithValues and (*zap.SugaredLogger).With.
+// Named loggers like (*zap.Logger).Named and klog.LoggerWithName, and V-leveled loggers like (logr.Logger).V
+// keep the keys of their parent.
+func isWithLikeFunc(fn *types.Func) bool {
+	name := fn.Name()
+	return strings.HasPrefix(name, "With") || strings.HasSuffix(name, "WithValues") ||
+		strings.HasSuffix(name, "WithName") || name == "Named" || name == "V"
+}
+
+// contextLoggerOf returns the expression carrying the logger through a context:
+// the logger stored by klog.NewContext(ctx, logger), or the context read by klog.FromContext(ctx).
+func contextLoggerOf(call checkers.CallContext) ast.Expr {
+	params := call.Signature.Params()
+	switch call.Func.Name() {
+	case "NewContext":
+		if params.Len() == 2 && len(call.Expr.Args) == 2 {
+			return call.Expr.Args[1]
+		}
+	case "FromContext", "FromContextOrDiscard":
+		if params.Len() == 1 && len(call.Expr.Args) == 1 {
+			return call.Expr.Args[0]
+		}
+	}
+	return nil
+}
+
+// loggerBaseOf returns the expression of the logger the call is made on:
+// the receiver for methods, or the first argument for functions like kitlog.With(logger, ...).
+func loggerBaseOf(call checkers.CallContext) ast.Expr {
+	if sel, ok := ast.Unparen(call.Expr.Fun).(*ast.SelectorExpr); ok && call.Signature.Recv() != nil {
+		return sel.X
+	}
+
+	params := call.Signature.Params()
+	results := call.Signature.Results()
+	if params.Len() > 1 && results.Len() == 1 && len(call.Expr.Args) > 0 &&
+		types.Identical(params.At(0).Type(), results.At(0).Type()) {
+		return call.Expr.Args[0]
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package loggercheck
+
+import (
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// factsResult is the result of the facts analyzer, it combines the facts of the current package
+// with the facts exported by dependencies.
+type factsResult struct {
+	fields fieldBindings // keys bound to logger fields, used by -nodupkeys
+	keys   keyUsages     // uses of logging keys, used by -nosimilarkeys
+}
+
+// newFactsAnalyzer returns an analyzer which collects facts about logging calls and exports them for
+// the packages importing the analyzed package. It is separated from loggercheck itself since analyzers
+// with facts run on every dependency as well, it returns immediately unless -nodupkeys or -nosimilarkeys is set.
+func newFactsAnalyzer(l *loggercheck) *analysis.Analyzer {
+	return &analysis.Analyzer{
+		Name:       "loggercheckfacts",
+		Doc:        "Collects facts about logging calls across packages, used by loggercheck.",
+		Run:        l.runFacts,
+		Requires:   []*analysis.Analyzer{inspect.Analyzer},
+		ResultType: reflect.TypeOf((*factsResult)(nil)),
+		FactTypes: []analysis.Fact{
+			new(boundKeysFact),
+			new(packageKeysFact),
+		},
+	}
+}
+
+func (l *loggercheck) runFacts(pass *analysis.Pass) (interface{}, error) {
+	result := &factsResult{}
+	if !l.noDupKeys && !l.noSimilarKeys {
+		return result, nil
+	}
+
+	// Errors are reported by loggercheck itself, dependencies are analyzed with the rules available.
+	_ = l.processConfig()
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	if l.noDupKeys {
+		result.fields = l.collectFieldBindings(pass)
+	}
+	if l.noSimilarKeys {
+		result.keys = l.collectKeyUsages(pass, insp)
+	}
+	return result, nil
+}
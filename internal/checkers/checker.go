@@ -1,40 +1,215 @@
 package checkers
 
 import (
+	"fmt"
 	"go/ast"
 	"go/types"
+	"sort"
 
 	"golang.org/x/tools/go/analysis"
+
+	"github.com/timonwong/loggercheck/internal/keystyle"
+	"github.com/timonwong/loggercheck/internal/msgstyle"
+	"github.com/timonwong/loggercheck/internal/registry"
+	"github.com/timonwong/loggercheck/internal/rules"
+	"github.com/timonwong/loggercheck/internal/sets"
 )
 
 type Config struct {
 	RequireStringKey bool
 	NoPrintfLike     bool
+	PrintfArgs       bool
+	NoSwappedKV      bool
+	NoDupKeys        bool
+	FoldKeys         bool
+	NoReservedKeys   bool
+	ReservedKeys     []string           // overrides Checker.ReservedKeys if not nil
+	KeyStyle         *keystyle.Style    // naming convention of logging keys, nil to disable
+	KeyRegistry      *registry.Registry // allowlist of logging keys, nil to disable
+	KeyPackages      sets.StringSet     // keys declared in these packages are allowed, even if not constant
+	NoSensitive      bool
+	SensitiveKeys    []string       // keys considered sensitive, matched by the last words of logging keys
+	SensitiveTypes   sets.StringSet // qualified names of sensitive types, for example "example.com/pkg.Token"
+	NoBadValues      bool
+	NoStringValues   bool
+	RequireConstMsg  bool
+	MessageStyle     *msgstyle.Style // style of constant messages, nil to disable
+	NoKVInMsg        bool
+	RequireKObj      bool
+	LibraryChecks    bool
+	MaxVLevel        int // maximal V level, 0 for unlimited
 }
 
 type CallContext struct {
 	Expr      *ast.CallExpr
 	Func      *types.Func
 	Signature *types.Signature
+	Ruleset   string // name of the matched ruleset
+	Kind      rules.FuncKind
+
+	MessageIndex int // index of the message argument, -1 if the function has no message
 }
 
 type Checker interface {
 	FilterKeyAndValues(pass *analysis.Pass, keyAndValues []ast.Expr) []ast.Expr
-	CheckLoggingKey(pass *analysis.Pass, keyAndValues []ast.Expr)
+	// FieldKey returns the key of a strongly-typed field constructed inline, for example: slog.String("key", v).
+	FieldKey(pass *analysis.Pass, arg ast.Expr) (Key, bool)
+	// FieldKeyExpr returns the key argument of a strongly-typed field constructed inline, constant or not.
+	FieldKeyExpr(pass *analysis.Pass, arg ast.Expr) (ast.Expr, bool)
+	// CheckLoggingKey reports keys which are not constant strings, or not ASCII.
+	CheckLoggingKey(pass *analysis.Pass, keys []ast.Expr, keyPackages sets.StringSet)
 	CheckPrintfLikeSpecifier(pass *analysis.Pass, args []ast.Expr)
+	CheckSwappedKeyValues(pass *analysis.Pass, keyAndValues []ast.Expr)
+	CheckDuplicateKeys(pass *analysis.Pass, keys []Key, foldKeys bool)
+	// ReservedKeys returns the keys added by the logger library itself when fn is called.
+	ReservedKeys(fn *types.Func) []string
+	CheckReservedKeys(pass *analysis.Pass, call CallContext, keys []Key, reservedKeys []string)
+	CheckKeyStyle(pass *analysis.Pass, keys []Key, style *keystyle.Style)
+	CheckKeyRegistry(pass *analysis.Pass, keys []Key, reg *registry.Registry)
+	CheckSensitiveKeys(pass *analysis.Pass, keys []Key, sensitiveKeys []string)
+	// CheckSecretValues reports constant strings which look like credentials, including the message.
+	CheckSecretValues(pass *analysis.Pass, args []ast.Expr)
+	// CheckSensitiveValues reports values whose type is sensitive and is not redacted by a marshaler method.
+	CheckSensitiveValues(pass *analysis.Pass, values []ast.Expr, sensitiveTypes sets.StringSet)
+	// CheckBadValues reports values whose static type gives useless or failing output with JSON encoders.
+	CheckBadValues(pass *analysis.Pass, values []ast.Expr)
+	// CheckStringifiedValues reports values converted to strings, like err.Error() and v.String(),
+	// args are checked for strongly-typed fields converting values to strings.
+	CheckStringifiedValues(pass *analysis.Pass, keyAndValues []ast.Expr, args []ast.Expr)
+	CheckConstantMessage(pass *analysis.Pass, call CallContext, msg ast.Expr)
+	CheckMessageStyle(pass *analysis.Pass, msg ast.Expr, style *msgstyle.Style)
+	// StructuredVariant returns the name of the method taking key-value pairs in place of the Sprint-style fn,
+	// for example "Infow" for (*zap.SugaredLogger).Infof, or an empty string if there is none.
+	StructuredVariant(fn *types.Func) string
+	// CheckKubernetesObjects reports Kubernetes objects logged as values, only their reference should be logged.
+	CheckKubernetesObjects(pass *analysis.Pass, values []ast.Expr)
+	// CheckVerbosity reports, with a positive maxLevel, the V levels above it or not constant.
+	CheckVerbosity(pass *analysis.Pass, call CallContext, maxLevel int)
+	// CheckLibraryCall reports misuses specific to the logger library, like errors logged with a key
+	// other than klog's "err". It is only called with Config.LibraryChecks.
+	CheckLibraryCall(pass *analysis.Pass, call CallContext, keyAndValues []ast.Expr)
+	// CheckMessageKeyValues reports key-value pairs written in the message text, like "user=%s".
+	CheckMessageKeyValues(pass *analysis.Pass, call CallContext, msg ast.Expr)
 }
 
-func ExecuteChecker(c Checker, pass *analysis.Pass, call CallContext, cfg Config) {
+// KeyAndValues returns the key-value pairs passed to the logging call,
+// strongly-typed fields are filtered out by the checker.
+func KeyAndValues(c Checker, pass *analysis.Pass, call CallContext) ([]ast.Expr, bool) {
+	args, keyValues, ok := variadicArgs(call)
+	if !ok {
+		return nil, false
+	}
+	if !keyValues {
+		return []ast.Expr{}, true
+	}
+
+	return c.FilterKeyAndValues(pass, args), true
+}
+
+// LoggingKeys returns the constant keys passed to the logging call, both the keys of key-value pairs
+// and the keys of strongly-typed fields constructed inline, in the order of appearance.
+func LoggingKeys(c Checker, pass *analysis.Pass, call CallContext) []Key {
+	args, keyValues, ok := variadicArgs(call)
+	if !ok {
+		return nil
+	}
+
+	var keys []Key
+	if keyValues {
+		keys = ConstantKeys(pass, c.FilterKeyAndValues(pass, args))
+	}
+	for _, arg := range args {
+		if key, ok := c.FieldKey(pass, arg); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Expr.Pos() < keys[j].Expr.Pos()
+	})
+	return keys
+}
+
+// KeyExprs returns the key arguments passed to the logging call, constant or not: both the keys of key-value pairs
+// and the keys of strongly-typed fields constructed inline, in the order of appearance.
+func KeyExprs(c Checker, pass *analysis.Pass, call CallContext) []ast.Expr {
+	args, keyValues, ok := variadicArgs(call)
+	if !ok {
+		return nil
+	}
+
+	var keys []ast.Expr
+	if keyValues {
+		keyAndValues := c.FilterKeyAndValues(pass, args)
+		for i := 0; i < len(keyAndValues); i += 2 {
+			keys = append(keys, keyAndValues[i])
+		}
+	}
+	for _, arg := range args {
+		if key, ok := c.FieldKeyExpr(pass, arg); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Pos() < keys[j].Pos()
+	})
+	return keys
+}
+
+// variadicArgs returns the arguments passed as the final variadic param, or the elements of a slice literal
+// passed as the final param, like the attrs of (slog.Handler).WithAttrs. keyValues is false if the arguments
+// are not ...interface{} but strongly-typed fields only, like ...slog.Attr.
+func variadicArgs(call CallContext) (args []ast.Expr, keyValues bool, ok bool) {
+	if call.Kind != rules.Structured {
+		return nil, false, false // arguments are formatted, not key-value pairs
+	}
+
 	params := call.Signature.Params()
-	nparams := params.Len() // variadic => nonzero
-	startIndex := nparams - 1
+	nparams := params.Len()
+	if nparams == 0 {
+		return nil, false, true
+	}
 
-	iface, ok := types.Unalias(params.At(startIndex).Type().(*types.Slice).Elem()).(*types.Interface)
-	if !ok || !iface.Empty() {
-		return // final (args) param is not ...interface{}
+	slice, isSlice := types.Unalias(params.At(nparams - 1).Type()).Underlying().(*types.Slice)
+	switch {
+	case !isSlice:
+		return nil, false, true
+	case call.Signature.Variadic():
+		args = call.Expr.Args[nparams-1:]
+	case len(call.Expr.Args) == nparams:
+		lit, isLit := ast.Unparen(call.Expr.Args[nparams-1]).(*ast.CompositeLit)
+		if !isLit {
+			return nil, false, true
+		}
+		args = lit.Elts
+	default:
+		return nil, false, true
 	}
 
-	keyValuesArgs := c.FilterKeyAndValues(pass, call.Expr.Args[startIndex:])
+	iface, isIface := types.Unalias(slice.Elem()).(*types.Interface)
+	return args, isIface && iface.Empty(), true
+}
+
+func ExecuteChecker(c Checker, pass *analysis.Pass, call CallContext, cfg Config) {
+	if call.Kind != rules.Structured {
+		reported := checkPrintKeyValues(c, pass, call)
+		if call.Kind == rules.Printf && cfg.PrintfArgs {
+			checkPrintfCall(pass, call, reported)
+		}
+		return
+	}
+
+	keyValuesArgs, ok := KeyAndValues(c, pass, call)
+	if !ok {
+		return
+	}
+
+	executeLibraryChecks(c, pass, call, keyValuesArgs, cfg)
+
+	if checkContainerArgs(pass, call, keyValuesArgs) {
+		return // the key-value pairs are not meaningful, report the container only
+	}
 
 	if len(keyValuesArgs)%2 != 0 {
 		firstArg := keyValuesArgs[0]
@@ -47,12 +222,160 @@ func ExecuteChecker(c Checker, pass *analysis.Pass, call CallContext, cfg Config
 		})
 	}
 
+	executeKeyChecks(c, pass, call, keyValuesArgs, cfg)
+	executeValueChecks(c, pass, call, keyValuesArgs, cfg)
+	executeMessageChecks(c, pass, call, cfg)
+}
+
+// executeLibraryChecks runs the checks of calls specific to the logger library, like group names and V levels.
+func executeLibraryChecks(c Checker, pass *analysis.Pass, call CallContext, keyValuesArgs []ast.Expr, cfg Config) {
+	c.CheckVerbosity(pass, call, cfg.MaxVLevel)
+	if cfg.LibraryChecks {
+		c.CheckLibraryCall(pass, call, keyValuesArgs)
+	}
+}
+
+// executeKeyChecks runs the checks of logging keys.
+func executeKeyChecks(c Checker, pass *analysis.Pass, call CallContext, keyValuesArgs []ast.Expr, cfg Config) {
 	if cfg.RequireStringKey {
-		c.CheckLoggingKey(pass, keyValuesArgs)
+		c.CheckLoggingKey(pass, KeyExprs(c, pass, call), cfg.KeyPackages)
+	}
+
+	if cfg.NoSwappedKV {
+		c.CheckSwappedKeyValues(pass, keyValuesArgs)
+	}
+
+	if cfg.NoDupKeys {
+		c.CheckDuplicateKeys(pass, LoggingKeys(c, pass, call), cfg.FoldKeys)
+	}
+
+	if cfg.NoReservedKeys {
+		reservedKeys := cfg.ReservedKeys
+		if reservedKeys == nil {
+			reservedKeys = c.ReservedKeys(call.Func)
+		}
+		c.CheckReservedKeys(pass, call, LoggingKeys(c, pass, call), reservedKeys)
+	}
+
+	if cfg.KeyStyle != nil || cfg.KeyRegistry != nil || cfg.NoSensitive {
+		keys := LoggingKeys(c, pass, call)
+		if cfg.KeyStyle != nil {
+			c.CheckKeyStyle(pass, keys, cfg.KeyStyle)
+		}
+		if cfg.KeyRegistry != nil {
+			c.CheckKeyRegistry(pass, keys, cfg.KeyRegistry)
+		}
+		if cfg.NoSensitive {
+			c.CheckSensitiveKeys(pass, keys, cfg.SensitiveKeys)
+		}
+	}
+}
+
+// executeValueChecks runs the checks of logging values.
+func executeValueChecks(c Checker, pass *analysis.Pass, call CallContext, keyValuesArgs []ast.Expr, cfg Config) {
+	if cfg.NoSensitive || cfg.NoBadValues {
+		values := LoggingValues(c, pass, call)
+		if cfg.NoSensitive {
+			c.CheckSecretValues(pass, call.Expr.Args)
+			c.CheckSensitiveValues(pass, values, cfg.SensitiveTypes)
+		}
+		if cfg.NoBadValues {
+			c.CheckBadValues(pass, values)
+		}
+	}
+
+	if cfg.RequireKObj {
+		c.CheckKubernetesObjects(pass, LoggingValues(c, pass, call))
+	}
+
+	if cfg.NoStringValues {
+		c.CheckStringifiedValues(pass, keyValuesArgs, call.Expr.Args)
+	}
+}
+
+// executeMessageChecks runs the checks of the logging message.
+func executeMessageChecks(c Checker, pass *analysis.Pass, call CallContext, cfg Config) {
+	if msg, ok := MessageArg(call); ok {
+		if cfg.RequireConstMsg {
+			c.CheckConstantMessage(pass, call, msg)
+		}
+		if cfg.MessageStyle != nil {
+			c.CheckMessageStyle(pass, msg, cfg.MessageStyle)
+		}
+		if cfg.NoKVInMsg {
+			c.CheckMessageKeyValues(pass, call, msg)
+		}
 	}
 
 	if cfg.NoPrintfLike {
-		// Check all args
-		c.CheckPrintfLikeSpecifier(pass, call.Expr.Args)
+		if msg, ok := MessageArg(call); ok {
+			c.CheckPrintfLikeSpecifier(pass, []ast.Expr{msg})
+		} else if call.MessageIndex < 0 {
+			// The rule does not declare the message, check all args
+			c.CheckPrintfLikeSpecifier(pass, call.Expr.Args)
+		}
+	}
+}
+
+// checkContainerArgs reports slices and maps passed as a single argument in place of a key,
+// for example: `log.Info("msg", kvs)` instead of `log.Info("msg", kvs...)`.
+func checkContainerArgs(pass *analysis.Pass, call CallContext, keyAndValues []ast.Expr) (reported bool) {
+	params := call.Signature.Params()
+	if params.Len() == 0 {
+		return false
+	}
+	variadicType := params.At(params.Len() - 1).Type()
+	if _, ok := variadicType.Underlying().(*types.Slice); !ok {
+		return false // the arguments are not key-value pairs
 	}
+	nvariadic := len(call.Expr.Args) - (params.Len() - 1)
+
+	for i := 0; i < len(keyAndValues); i += 2 {
+		arg := keyAndValues[i]
+		typ := pass.TypesInfo.TypeOf(arg)
+		if typ == nil {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      arg.Pos(),
+			End:      arg.End(),
+			Category: DiagnosticCategory,
+		}
+		argStr := renderNodeEllipsis(pass.Fset, arg)
+		switch t := typ.Underlying().(type) {
+		case *types.Slice:
+			if isByteType(t.Elem()) {
+				continue // []byte is string-like
+			}
+
+			diag.Message = fmt.Sprintf("slice %s is passed as a single logging argument, "+
+				"its elements are not expanded into key-value pairs", argStr)
+			if nvariadic == 1 && types.Identical(typ, variadicType) {
+				diag.Message = fmt.Sprintf("slice %s is passed as a single logging argument, use %s... instead", argStr, argStr)
+				diag.SuggestedFixes = []analysis.SuggestedFix{
+					{
+						Message: "Expand the slice",
+						TextEdits: []analysis.TextEdit{
+							{Pos: arg.End(), End: arg.End(), NewText: []byte("...")},
+						},
+					},
+				}
+			}
+		case *types.Map:
+			diag.Message = fmt.Sprintf("map %s is passed as a single logging argument, "+
+				"its entries are not expanded into key-value pairs", argStr)
+		default:
+			continue
+		}
+
+		pass.Report(diag)
+		reported = true
+	}
+	return reported
+}
+
+func isByteType(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Kind() == types.Byte
 }
@@ -1,15 +1,21 @@
 package checkers
 
 import (
+	"fmt"
 	"go/ast"
 	"go/constant"
 	"go/printer"
 	"go/token"
 	"go/types"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/timonwong/loggercheck/internal/rules"
+	"github.com/timonwong/loggercheck/internal/sets"
 )
 
 const (
@@ -17,9 +23,10 @@ const (
 )
 
 // extractValueFromStringArg returns true if the argument is a string type (literal or constant).
+// Constants of named string types are accepted as well, for example: `const KeyUser LogKey = "user"`.
 func extractValueFromStringArg(pass *analysis.Pass, arg ast.Expr) (value string, ok bool) {
 	if typeAndValue, ok := pass.TypesInfo.Types[arg]; ok {
-		if typ, ok := typeAndValue.Type.(*types.Basic); ok && typ.Kind() == types.String && typeAndValue.Value != nil {
+		if typ, ok := typeAndValue.Type.Underlying().(*types.Basic); ok && typ.Kind() == types.String && typeAndValue.Value != nil {
 			return constant.StringVal(typeAndValue.Value), true
 		}
 	}
@@ -27,12 +34,277 @@ func extractValueFromStringArg(pass *analysis.Pass, arg ast.Expr) (value string,
 	return "", false
 }
 
-func renderNodeEllipsis(fset *token.FileSet, v interface{}) string {
-	const maxLen = 20
+// extractValueFromIntArg returns true if the argument is an integer constant which fits into an int64.
+func extractValueFromIntArg(pass *analysis.Pass, arg ast.Expr) (value int64, ok bool) {
+	typeAndValue, ok := pass.TypesInfo.Types[arg]
+	if !ok || typeAndValue.Value == nil || typeAndValue.Value.Kind() != constant.Int {
+		return 0, false
+	}
+
+	return constant.Int64Val(typeAndValue.Value)
+}
+
+// Key is a logging key whose value is known at compile time.
+type Key struct {
+	Expr      ast.Expr // the key argument
+	Name      string   // the constant value of the key
+	Value     ast.Expr // the value argument, nil if unknown
+	Namespace bool     // the keys following this one are nested in it, like zap.Namespace
+}
+
+// ConstantKeys returns all the keys of key-value pairs which are constant strings.
+func ConstantKeys(pass *analysis.Pass, keyAndValues []ast.Expr) []Key {
+	var keys []Key
+	for i := 0; i < len(keyAndValues); i += 2 {
+		arg := keyAndValues[i]
+		if name, ok := extractValueFromStringArg(pass, arg); ok {
+			key := Key{Expr: arg, Name: name}
+			if i+1 < len(keyAndValues) {
+				key.Value = keyAndValues[i+1]
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// renameKeyFixes returns the fix which renames the key, only keys of string literals can be renamed.
+func renameKeyFixes(key Key, newName string) []analysis.SuggestedFix {
+	lit, ok := ast.Unparen(key.Expr).(*ast.BasicLit)
+	if !ok {
+		return nil
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message: fmt.Sprintf("Rename key to %q", newName),
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     lit.Pos(),
+					End:     lit.End(),
+					NewText: []byte(strconv.Quote(newName)),
+				},
+			},
+		},
+	}
+}
+
+// isDeclaredInPackages returns true if the argument refers to a variable or constant declared in one of the packages,
+// for example: logkeys.User.
+func isDeclaredInPackages(pass *analysis.Pass, arg ast.Expr, pkgPaths sets.StringSet) bool {
+	if len(pkgPaths) == 0 {
+		return false
+	}
+
+	var ident *ast.Ident
+	switch arg := ast.Unparen(arg).(type) {
+	case *ast.Ident:
+		ident = arg
+	case *ast.SelectorExpr:
+		ident = arg.Sel
+	default:
+		return false
+	}
+
+	switch obj := pass.TypesInfo.Uses[ident].(type) {
+	case *types.Var, *types.Const:
+		return obj.Pkg() != nil && pkgPaths.Has(rules.VendorLessPath(obj.Pkg().Path()))
+	default:
+		return false
+	}
+}
+
+// isStringConstant returns true if the argument is a constant string.
+func isStringConstant(pass *analysis.Pass, arg ast.Expr) bool {
+	_, ok := extractValueFromStringArg(pass, arg)
+	return ok
+}
+
+// keyValuePairsRelated describes how the arguments are paired up, so that a shift is easy to spot.
+func keyValuePairsRelated(pass *analysis.Pass, keyAndValues []ast.Expr) []analysis.RelatedInformation {
+	related := make([]analysis.RelatedInformation, 0, (len(keyAndValues)+1)/2)
+	for i := 0; i < len(keyAndValues); i += 2 {
+		key := keyAndValues[i]
+		if i+1 == len(keyAndValues) {
+			related = append(related, analysis.RelatedInformation{
+				Pos:     key.Pos(),
+				End:     key.End(),
+				Message: fmt.Sprintf("key %s has no value", renderNodeEllipsis(pass.Fset, key)),
+			})
+			break
+		}
+
+		value := keyAndValues[i+1]
+		related = append(related, analysis.RelatedInformation{
+			Pos: key.Pos(),
+			End: value.End(),
+			Message: fmt.Sprintf("key %s, value %s",
+				renderNodeEllipsis(pass.Fset, key), renderNodeEllipsis(pass.Fset, value)),
+		})
+	}
+	return related
+}
+
+// isNonStringValue returns true if the argument is known to hold a value which can never be a string.
+func isNonStringValue(pass *analysis.Pass, arg ast.Expr) bool {
+	typ := pass.TypesInfo.TypeOf(arg)
+	if typ == nil {
+		return false
+	}
+
+	switch typ := typ.Underlying().(type) {
+	case *types.Basic:
+		return typ.Info()&types.IsString == 0 && typ.Kind() != types.UntypedNil
+	case *types.Interface:
+		// Empty interfaces may hold a string at runtime, a string does not implement error or fmt.Stringer.
+		return typ.NumMethods() > 0
+	default:
+		return true
+	}
+}
+
+// valueTypeMatches returns true if the value can be assigned to the type named typeName,
+// for example: "int64", "time.Duration" or "*example.com/pkg.Type".
+func valueTypeMatches(pass *analysis.Pass, value ast.Expr, typeName string) bool {
+	valueType := pass.TypesInfo.TypeOf(value)
+	if valueType == nil {
+		return true // unknown type, assume it's OK
+	}
+
+	// Untyped constants have their default type recorded, since they are converted to interface{}.
+	if untyped := untypedConstantType(pass, value); untyped != nil {
+		valueType = untyped
+	}
+
+	if expected := lookupType(pass.Pkg, typeName); expected != nil {
+		return types.AssignableTo(valueType, expected)
+	}
+
+	// The package of the type is not imported, compare by names instead.
+	qualifier := func(pkg *types.Package) string {
+		return rules.VendorLessPath(pkg.Path())
+	}
+	return types.TypeString(types.Default(valueType), qualifier) == typeName
+}
+
+// untypedConstantType returns the untyped type of constant expressions like 42 or `const N = 42`.
+func untypedConstantType(pass *analysis.Pass, expr ast.Expr) types.Type {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || !isUntypedConstantExpr(pass, expr) {
+		return nil
+	}
+
+	switch tv.Value.Kind() {
+	case constant.Bool:
+		return types.Typ[types.UntypedBool]
+	case constant.String:
+		return types.Typ[types.UntypedString]
+	case constant.Int:
+		return types.Typ[types.UntypedInt]
+	case constant.Float:
+		return types.Typ[types.UntypedFloat]
+	default:
+		return nil
+	}
+}
+
+func isUntypedConstantExpr(pass *analysis.Pass, expr ast.Expr) bool {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.Ident:
+		c, ok := pass.TypesInfo.Uses[expr].(*types.Const)
+		if !ok {
+			return false
+		}
+		basic, ok := c.Type().(*types.Basic)
+		return ok && basic.Info()&types.IsUntyped != 0
+	case *ast.ParenExpr:
+		return isUntypedConstantExpr(pass, expr.X)
+	case *ast.UnaryExpr:
+		return isUntypedConstantExpr(pass, expr.X)
+	case *ast.BinaryExpr:
+		return isUntypedConstantExpr(pass, expr.X) && isUntypedConstantExpr(pass, expr.Y)
+	default:
+		return false
+	}
+}
+
+// lookupType resolves the type name from the universe scope, the current package or the packages it imports.
+func lookupType(pkg *types.Package, typeName string) types.Type {
+	if elem, ok := strings.CutPrefix(typeName, "*"); ok {
+		if typ := lookupType(pkg, elem); typ != nil {
+			return types.NewPointer(typ)
+		}
+		return nil
+	}
+
+	if obj, ok := types.Universe.Lookup(typeName).(*types.TypeName); ok {
+		return obj.Type()
+	}
 
+	dot := strings.LastIndex(typeName, ".")
+	if dot < 0 {
+		return nil
+	}
+
+	pkgPath, name := typeName[:dot], typeName[dot+1:]
+	for _, imp := range append([]*types.Package{pkg}, pkg.Imports()...) {
+		if rules.VendorLessPath(imp.Path()) != pkgPath {
+			continue
+		}
+		if obj, ok := imp.Scope().Lookup(name).(*types.TypeName); ok {
+			return obj.Type()
+		}
+	}
+
+	return nil
+}
+
+// initialValueOf returns the value the local variable is declared with, like v in `l := v`, or nil if the variable
+// is declared without a value or with the results of a multi-value call.
+func initialValueOf(pass *analysis.Pass, v *types.Var) ast.Expr {
+	file := fileOf(pass, v.Pos())
+	if file == nil || v.Parent() == nil || v.Parent() == v.Pkg().Scope() {
+		return nil
+	}
+
+	path, _ := astutil.PathEnclosingInterval(file, v.Pos(), v.Pos())
+	for _, node := range path {
+		switch node := node.(type) {
+		case *ast.AssignStmt:
+			if node.Tok == token.DEFINE && len(node.Lhs) == len(node.Rhs) {
+				for i, lhs := range node.Lhs {
+					if lhs.Pos() == v.Pos() {
+						return node.Rhs[i]
+					}
+				}
+			}
+			return nil
+		case *ast.ValueSpec:
+			if len(node.Names) == len(node.Values) {
+				for i, name := range node.Names {
+					if name.Pos() == v.Pos() {
+						return node.Values[i]
+					}
+				}
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func renderNode(fset *token.FileSet, v interface{}) string {
 	buf := &strings.Builder{}
 	_ = printer.Fprint(buf, fset, v)
-	s := buf.String()
+	return buf.String()
+}
+
+func renderNodeEllipsis(fset *token.FileSet, v interface{}) string {
+	const maxLen = 20
+
+	s := renderNode(fset, v)
 	if utf8.RuneCountInString(s) > maxLen {
 		// Copied from go/constant/value.go
 		i := 0
@@ -5,6 +5,7 @@ import (
 	"go/types"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
 )
 
 func filterKeyAndValues(pass *analysis.Pass, keyAndValues []ast.Expr, objName string) []ast.Expr {
@@ -33,3 +34,58 @@ func filterKeyAndValues(pass *analysis.Pass, keyAndValues []ast.Expr, objName st
 
 	return filtered
 }
+
+// fieldKeyOf returns the key of strongly-typed field constructor calls, like slog.String("key", v) or
+// zap.String("key", v): the result type is named objName and the first parameter is "key string".
+func fieldKeyOf(pass *analysis.Pass, arg ast.Expr, objName string) (Key, bool) {
+	call, ok := fieldConstructorCall(pass, arg, objName)
+	if !ok {
+		return Key{}, false
+	}
+
+	name, ok := extractValueFromStringArg(pass, call.Args[0])
+	if !ok {
+		return Key{}, false
+	}
+	key := Key{Expr: call.Args[0], Name: name}
+	if len(call.Args) > 1 {
+		key.Value = call.Args[1]
+	}
+	return key, true
+}
+
+// fieldKeyExprOf returns the key argument of strongly-typed field constructor calls, constant or not.
+func fieldKeyExprOf(pass *analysis.Pass, arg ast.Expr, objName string) (ast.Expr, bool) {
+	call, ok := fieldConstructorCall(pass, arg, objName)
+	if !ok {
+		return nil, false
+	}
+	return call.Args[0], true
+}
+
+func fieldConstructorCall(pass *analysis.Pass, arg ast.Expr, objName string) (*ast.CallExpr, bool) {
+	call, ok := ast.Unparen(arg).(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return nil, false
+	}
+
+	fn, _ := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if fn == nil {
+		return nil, false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() == 0 || sig.Results().Len() != 1 {
+		return nil, false
+	}
+
+	named, ok := types.Unalias(sig.Results().At(0).Type()).(*types.Named)
+	if !ok || named.Obj().Name() != objName {
+		return nil, false
+	}
+
+	if param := sig.Params().At(0); param.Name() != "key" {
+		return nil, false
+	}
+	return call, true
+}
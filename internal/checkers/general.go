@@ -3,10 +3,16 @@ package checkers
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
+	"slices"
 
 	"golang.org/x/tools/go/analysis"
 
 	"github.com/timonwong/loggercheck/internal/checkers/printf"
+	"github.com/timonwong/loggercheck/internal/keystyle"
+	"github.com/timonwong/loggercheck/internal/registry"
+	"github.com/timonwong/loggercheck/internal/secrets"
+	"github.com/timonwong/loggercheck/internal/sets"
 	"github.com/timonwong/loggercheck/internal/stringutil"
 )
 
@@ -16,9 +22,16 @@ func (g General) FilterKeyAndValues(_ *analysis.Pass, keyAndValues []ast.Expr) [
 	return keyAndValues
 }
 
-func (g General) CheckLoggingKey(pass *analysis.Pass, keyAndValues []ast.Expr) {
-	for i := 0; i < len(keyAndValues); i += 2 {
-		arg := keyAndValues[i]
+func (g General) FieldKey(_ *analysis.Pass, _ ast.Expr) (Key, bool) {
+	return Key{}, false
+}
+
+func (g General) FieldKeyExpr(_ *analysis.Pass, _ ast.Expr) (ast.Expr, bool) {
+	return nil, false
+}
+
+func (g General) CheckLoggingKey(pass *analysis.Pass, keys []ast.Expr, keyPackages sets.StringSet) {
+	for _, arg := range keys {
 		if value, ok := extractValueFromStringArg(pass, arg); ok {
 			if stringutil.IsASCII(value) {
 				continue
@@ -32,7 +45,7 @@ func (g General) CheckLoggingKey(pass *analysis.Pass, keyAndValues []ast.Expr) {
 					"logging keys are expected to be alphanumeric strings, please remove any non-latin characters from %q",
 					value),
 			})
-		} else {
+		} else if !isDeclaredInPackages(pass, arg, keyPackages) {
 			pass.Report(analysis.Diagnostic{
 				Pos:      arg.Pos(),
 				End:      arg.End(),
@@ -65,4 +78,272 @@ func (g General) CheckPrintfLikeSpecifier(pass *analysis.Pass, args []ast.Expr)
 	}
 }
 
+func (g General) CheckSwappedKeyValues(pass *analysis.Pass, keyAndValues []ast.Expr) {
+	for i := 0; i < len(keyAndValues); i += 2 {
+		key := keyAndValues[i]
+		if !isNonStringValue(pass, key) {
+			continue
+		}
+
+		var (
+			arg     ast.Expr
+			message string
+		)
+		switch {
+		case i > 0 && isStringConstant(pass, keyAndValues[i-1]):
+			// log.Info("m", "user", "id", userID): "id" occupies the value position of "user".
+			arg = keyAndValues[i-1]
+			message = fmt.Sprintf(
+				"key-value pairs seem shifted, %s is passed as a value but looks like a key",
+				renderNodeEllipsis(pass.Fset, arg))
+		case i+1 < len(keyAndValues) && isStringConstant(pass, keyAndValues[i+1]):
+			// log.Info("m", userID, "user"): key and value are swapped.
+			arg = key
+			message = fmt.Sprintf(
+				"key-value pair seems swapped, %s is passed as a key but %s looks like the key",
+				renderNodeEllipsis(pass.Fset, key), renderNodeEllipsis(pass.Fset, keyAndValues[i+1]))
+		default:
+			continue
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      arg.Pos(),
+			End:      arg.End(),
+			Category: DiagnosticCategory,
+			Message:  message,
+			Related:  keyValuePairsRelated(pass, keyAndValues),
+		})
+
+		return // One error diagnostic is enough
+	}
+}
+
+func (g General) CheckDuplicateKeys(pass *analysis.Pass, keys []Key, foldKeys bool) {
+	seen := make(map[string]Key)
+	for i, key := range keys {
+		normalized := key.Name
+		if foldKeys {
+			normalized = stringutil.NormalizeKey(key.Name)
+		}
+
+		if prev, ok := seen[normalized]; ok {
+			reportDuplicateKey(pass, key, prev)
+		} else {
+			seen[normalized] = key
+		}
+
+		if key.Namespace {
+			// The following keys are nested in the namespace, they may reuse the keys of the parent.
+			g.CheckDuplicateKeys(pass, keys[i+1:], foldKeys)
+			return
+		}
+	}
+}
+
+func reportDuplicateKey(pass *analysis.Pass, key, prev Key) {
+	message := fmt.Sprintf("duplicate logging key %q", key.Name)
+	if prev.Name != key.Name {
+		message = fmt.Sprintf("logging key %q duplicates %q, they only differ in case or separator", key.Name, prev.Name)
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      key.Expr.Pos(),
+		End:      key.Expr.End(),
+		Category: DiagnosticCategory,
+		Message:  message,
+		Related: []analysis.RelatedInformation{
+			{
+				Pos:     prev.Expr.Pos(),
+				End:     prev.Expr.End(),
+				Message: fmt.Sprintf("key %q first used here", prev.Name),
+			},
+		},
+	})
+}
+
+func (g General) ReservedKeys(_ *types.Func) []string {
+	return nil
+}
+
+func (g General) StructuredVariant(_ *types.Func) string {
+	return ""
+}
+
+func (g General) CheckVerbosity(_ *analysis.Pass, _ CallContext, _ int) {}
+
+func (g General) CheckLibraryCall(_ *analysis.Pass, _ CallContext, _ []ast.Expr) {}
+
+func (g General) CheckKubernetesObjects(_ *analysis.Pass, _ []ast.Expr) {}
+
+// CheckReservedKeys reports keys colliding with the reserved keys, keys following a namespace like zap.Namespace
+// are nested in it and are not reported.
+func (g General) CheckReservedKeys(pass *analysis.Pass, _ CallContext, keys []Key, reservedKeys []string) {
+	if len(reservedKeys) == 0 {
+		return
+	}
+
+	for _, key := range keys {
+		if slices.Contains(reservedKeys, key.Name) {
+			pass.Report(analysis.Diagnostic{
+				Pos:      key.Expr.Pos(),
+				End:      key.Expr.End(),
+				Category: DiagnosticCategory,
+				Message:  fmt.Sprintf("logging key %q collides with the key reserved by the logger", key.Name),
+			})
+		}
+		if key.Namespace {
+			return
+		}
+	}
+}
+
+func (g General) CheckKeyStyle(pass *analysis.Pass, keys []Key, style *keystyle.Style) {
+	for _, key := range keys {
+		if style.Match(key.Name) {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      key.Expr.Pos(),
+			End:      key.Expr.End(),
+			Category: DiagnosticCategory,
+			Message:  fmt.Sprintf("logging key %q does not follow the key style %q", key.Name, style),
+		}
+		if converted, ok := style.Convert(key.Name); ok {
+			diag.Message += fmt.Sprintf(", use %q instead", converted)
+			diag.SuggestedFixes = renameKeyFixes(key, converted)
+		}
+		pass.Report(diag)
+	}
+}
+
+func (g General) CheckKeyRegistry(pass *analysis.Pass, keys []Key, reg *registry.Registry) {
+	for _, key := range keys {
+		entry, ok := reg.Lookup(key.Name)
+		if !ok {
+			g.reportUnknownKey(pass, key, reg)
+			continue
+		}
+
+		if entry.Type == "" || key.Value == nil || valueTypeMatches(pass, key.Value, entry.Type) {
+			continue
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      key.Value.Pos(),
+			End:      key.Value.End(),
+			Category: DiagnosticCategory,
+			Message: fmt.Sprintf("logging key %q expects a value of type %s, got %s",
+				key.Name, entry.Type, pass.TypesInfo.TypeOf(key.Value)),
+		})
+	}
+}
+
+func (g General) reportUnknownKey(pass *analysis.Pass, key Key, reg *registry.Registry) {
+	diag := analysis.Diagnostic{
+		Pos:      key.Expr.Pos(),
+		End:      key.Expr.End(),
+		Category: DiagnosticCategory,
+		Message:  fmt.Sprintf("logging key %q is not in the key registry", key.Name),
+	}
+
+	if nearest, ok := reg.Nearest(key.Name); ok {
+		diag.Message += fmt.Sprintf(", did you mean %q?", nearest)
+		diag.SuggestedFixes = renameKeyFixes(key, nearest)
+	}
+
+	pass.Report(diag)
+}
+
+func (g General) CheckSensitiveKeys(pass *analysis.Pass, keys []Key, sensitiveKeys []string) {
+	for _, key := range keys {
+		matched, ok := secrets.MatchKey(key.Name, sensitiveKeys)
+		if !ok {
+			continue
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      key.Expr.Pos(),
+			End:      key.Expr.End(),
+			Category: DiagnosticCategory,
+			Message:  fmt.Sprintf("logging key %q looks sensitive, it matches %q", key.Name, matched),
+		})
+	}
+}
+
+func (g General) CheckSecretValues(pass *analysis.Pass, args []ast.Expr) {
+	for _, arg := range args {
+		// Values of strongly-typed fields are nested, for example: zap.String("key", "AKIA...").
+		ast.Inspect(arg, func(node ast.Node) bool {
+			expr, ok := node.(ast.Expr)
+			if !ok {
+				return true
+			}
+
+			value, ok := extractValueFromStringArg(pass, expr)
+			if !ok {
+				return true
+			}
+
+			if kind, found := secrets.Find(value); found {
+				pass.Report(analysis.Diagnostic{
+					Pos:      expr.Pos(),
+					End:      expr.End(),
+					Category: DiagnosticCategory,
+					Message:  fmt.Sprintf("constant string looks like %s, credentials must not be logged", kind),
+				})
+			}
+			return false
+		})
+	}
+}
+
+func (g General) CheckSensitiveValues(pass *analysis.Pass, values []ast.Expr, sensitiveTypes sets.StringSet) {
+	for _, value := range values {
+		typ := pass.TypesInfo.TypeOf(value)
+		if typ == nil {
+			continue
+		}
+
+		reason, ok := sensitiveReason(typ, sensitiveTypes)
+		if !ok {
+			continue
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      value.Pos(),
+			End:      value.End(),
+			Category: DiagnosticCategory,
+			Message: fmt.Sprintf("logging value of type %s is sensitive, %s: redact it with a LogValue, "+
+				"MarshalLogObject or MarshalLog method", types.TypeString(typ, types.RelativeTo(pass.Pkg)), reason),
+		})
+	}
+}
+
+func (g General) CheckBadValues(pass *analysis.Pass, values []ast.Expr) {
+	for _, value := range values {
+		typ := pass.TypesInfo.TypeOf(value)
+		if typ == nil {
+			continue
+		}
+
+		qf := types.RelativeTo(pass.Pkg)
+		reason, ok := badValueReason(typ, qf)
+		if !ok {
+			continue
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      value.Pos(),
+			End:      value.End(),
+			Category: DiagnosticCategory,
+			Message:  fmt.Sprintf("logging value of type %s serializes badly, %s", types.TypeString(typ, qf), reason),
+		})
+	}
+}
+
+func (g General) CheckStringifiedValues(pass *analysis.Pass, keyAndValues []ast.Expr, _ []ast.Expr) {
+	checkStringifiedKeyValues(pass, keyAndValues)
+}
+
 var _ Checker = (*General)(nil)
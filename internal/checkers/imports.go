@@ -0,0 +1,115 @@
+package checkers
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+func fileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, file := range pass.Files {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return file
+		}
+	}
+	return nil
+}
+
+// importedName returns the name the package is imported with, or defaultName if it is not imported.
+func importedName(file *ast.File, path, defaultName string) (string, bool) {
+	for _, spec := range file.Imports {
+		if importPath, _ := strconv.Unquote(spec.Path.Value); importPath != path {
+			continue
+		}
+		if spec.Name != nil {
+			return spec.Name.Name, true
+		}
+		return defaultName, true
+	}
+	return defaultName, false
+}
+
+// addImportEdit adds the import to the first import declaration of the file.
+func addImportEdit(file *ast.File, path string) (analysis.TextEdit, bool) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT || !gen.Lparen.IsValid() || len(gen.Specs) == 0 {
+			continue
+		}
+
+		last := gen.Specs[len(gen.Specs)-1]
+		return analysis.TextEdit{Pos: last.End(), End: last.End(), NewText: []byte("\n\t" + strconv.Quote(path))}, true
+	}
+	return analysis.TextEdit{}, false
+}
+
+// unusedImportEdits removes the imports of the file which are only used by the removed expression,
+// like the fmt import of a fmt.Sprintf call replaced with a constant.
+func unusedImportEdits(pass *analysis.Pass, file *ast.File, removed ast.Expr) []analysis.TextEdit {
+	inRemoved := make(map[*types.PkgName]bool)
+	usedElsewhere := make(map[*types.PkgName]bool)
+	ast.Inspect(file, func(node ast.Node) bool {
+		ident, ok := node.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		if pkgName, ok := pass.TypesInfo.Uses[ident].(*types.PkgName); ok {
+			if removed.Pos() <= ident.Pos() && ident.End() <= removed.End() {
+				inRemoved[pkgName] = true
+			} else {
+				usedElsewhere[pkgName] = true
+			}
+		}
+		return true
+	})
+
+	var edits []analysis.TextEdit
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+
+		var unused []ast.Spec
+		for _, spec := range gen.Specs {
+			pkgName := importedPkgName(pass, spec.(*ast.ImportSpec))
+			if inRemoved[pkgName] && !usedElsewhere[pkgName] {
+				unused = append(unused, spec)
+			}
+		}
+
+		if len(unused) == len(gen.Specs) && len(unused) > 0 {
+			edits = append(edits, lineDeleteEdit(pass, gen))
+			continue
+		}
+		for _, spec := range unused {
+			edits = append(edits, lineDeleteEdit(pass, spec))
+		}
+	}
+	return edits
+}
+
+func importedPkgName(pass *analysis.Pass, spec *ast.ImportSpec) *types.PkgName {
+	var obj types.Object
+	if spec.Name != nil {
+		obj = pass.TypesInfo.Defs[spec.Name]
+	} else {
+		obj = pass.TypesInfo.Implicits[spec]
+	}
+	pkgName, _ := obj.(*types.PkgName)
+	return pkgName
+}
+
+// lineDeleteEdit deletes the node with the lines it spans.
+func lineDeleteEdit(pass *analysis.Pass, node ast.Node) analysis.TextEdit {
+	file := pass.Fset.File(node.Pos())
+	start := file.LineStart(file.Line(node.Pos()))
+	end := node.End()
+	if line := file.Line(end); line < file.LineCount() {
+		end = file.LineStart(line + 1)
+	}
+	return analysis.TextEdit{Pos: start, End: end}
+}
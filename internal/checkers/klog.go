@@ -0,0 +1,161 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/timonwong/loggercheck/internal/rules"
+	"github.com/timonwong/loggercheck/internal/stringutil"
+)
+
+// klogErrorKey is the key klog uses for the error passed to ErrorS, and the key the Kubernetes logging guidelines
+// require for errors logged with InfoS.
+const klogErrorKey = "err"
+
+type Klog struct {
+	General
+}
+
+// ReservedKeys returns the implicit "err" key, which klog adds for the error passed to ErrorS.
+func (k Klog) ReservedKeys(fn *types.Func) []string {
+	switch fn.Name() {
+	case "ErrorS", "ErrorSDepth":
+		return []string{klogErrorKey}
+	default:
+		return nil
+	}
+}
+
+func (k Klog) CheckKubernetesObjects(pass *analysis.Pass, values []ast.Expr) {
+	checkKubernetesObjects(pass, values)
+}
+
+// CheckLibraryCall reports errors logged with a key other than "err", object references without a name,
+// KObjSlice arguments which are logged as an error string, negative ktesting verbosity and names passed to
+// LoggerWithName which are empty, or not made of letters, digits and hyphens.
+func (k Klog) CheckLibraryCall(pass *analysis.Pass, call CallContext, keyAndValues []ast.Expr) {
+	switch call.Func.Name() {
+	case "ErrorS", "ErrorSDepth":
+		// the error is passed as a parameter and logged with the key "err" already.
+	case "LoggerWithName":
+		if len(call.Expr.Args) == 2 {
+			checkLoggerName(pass, call.Func.Name(), call.Expr.Args[1])
+		}
+	case "KRef":
+		k.checkKRef(pass, call)
+	case "KObjSlice":
+		k.checkKObjSlice(pass, call)
+	case "Verbosity":
+		k.checkTestingVerbosity(pass, call)
+	default:
+		k.checkErrorKeys(pass, keyAndValues)
+	}
+}
+
+// checkErrorKeys reports errors logged with a key spelled like "err" or "error", but not "err" exactly.
+func (k Klog) checkErrorKeys(pass *analysis.Pass, keyAndValues []ast.Expr) {
+	for _, key := range ConstantKeys(pass, keyAndValues) {
+		if key.Name == klogErrorKey || key.Value == nil {
+			continue
+		}
+		if normalized := stringutil.NormalizeKey(key.Name); normalized != "err" && normalized != "error" {
+			continue
+		}
+		if typ := pass.TypesInfo.TypeOf(key.Value); typ == nil || !types.Implements(typ, errorType) {
+			continue
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:      key.Expr.Pos(),
+			End:      key.Expr.End(),
+			Category: DiagnosticCategory,
+			Message: fmt.Sprintf("error is logged with key %q, klog logs errors with key %q",
+				key.Name, klogErrorKey),
+			SuggestedFixes: renameKeyFixes(key, klogErrorKey),
+		})
+	}
+}
+
+func (k Klog) checkKRef(pass *analysis.Pass, call CallContext) {
+	if len(call.Expr.Args) != 2 {
+		return
+	}
+
+	name := call.Expr.Args[1]
+	if value, ok := extractValueFromStringArg(pass, name); !ok || value != "" {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      name.Pos(),
+		End:      name.End(),
+		Category: DiagnosticCategory,
+		Message:  "KRef is called with an empty name, the namespace is passed first: KRef(namespace, name)",
+	})
+}
+
+// checkKObjSlice reports arguments klog cannot convert to object references, it logs an error string
+// in place of the objects, like "<KObjSlice needs a slice, got type T>".
+func (k Klog) checkKObjSlice(pass *analysis.Pass, call CallContext) {
+	if len(call.Expr.Args) != 1 {
+		return
+	}
+
+	arg := call.Expr.Args[0]
+	typ := pass.TypesInfo.TypeOf(arg)
+	if typ == nil || types.IsInterface(typ) || typ == types.Typ[types.UntypedNil] {
+		return
+	}
+
+	qf := types.RelativeTo(pass.Pkg)
+	slice, ok := typ.Underlying().(*types.Slice)
+	if !ok {
+		k.reportKObjSlice(pass, arg, fmt.Sprintf("KObjSlice needs a slice, got type %s", types.TypeString(typ, qf)))
+		return
+	}
+
+	obj := call.Func.Pkg().Scope().Lookup("KMetadata")
+	if obj == nil || types.IsInterface(slice.Elem()) {
+		return
+	}
+	if metadata, ok := obj.Type().Underlying().(*types.Interface); !ok || types.Implements(slice.Elem(), metadata) {
+		return
+	}
+	k.reportKObjSlice(pass, arg, fmt.Sprintf("KObjSlice needs a slice of values implementing KMetadata, got type %s",
+		types.TypeString(slice.Elem(), qf)))
+}
+
+func (k Klog) reportKObjSlice(pass *analysis.Pass, arg ast.Expr, problem string) {
+	pass.Report(analysis.Diagnostic{
+		Pos:      arg.Pos(),
+		End:      arg.End(),
+		Category: DiagnosticCategory,
+		Message:  problem + ", the error is logged in place of the objects",
+	})
+}
+
+// checkTestingVerbosity reports negative levels passed to ktesting.Verbosity, the test logger drops
+// all the info messages then.
+func (k Klog) checkTestingVerbosity(pass *analysis.Pass, call CallContext) {
+	if rules.VendorLessPath(call.Func.Pkg().Path()) != "k8s.io/klog/v2/ktesting" || len(call.Expr.Args) != 1 {
+		return
+	}
+
+	level, ok := extractValueFromIntArg(pass, call.Expr.Args[0])
+	if !ok || level >= 0 {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      call.Expr.Args[0].Pos(),
+		End:      call.Expr.Args[0].End(),
+		Category: DiagnosticCategory,
+		Message: fmt.Sprintf("ktesting.Verbosity is called with negative level %d, "+
+			"the test logger drops all the info messages", level),
+	})
+}
+
+var _ Checker = (*Klog)(nil)
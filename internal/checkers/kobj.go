@@ -0,0 +1,169 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/timonwong/loggercheck/internal/rules"
+)
+
+const (
+	klogPath   = "k8s.io/klog/v2"
+	metav1Path = "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// checkKubernetesObjects reports Kubernetes objects logged as values, the structured logging guidelines require
+// to log their reference only, with klog.KObj, klog.KRef or klog.KObjSlice.
+func checkKubernetesObjects(pass *analysis.Pass, values []ast.Expr) {
+	for _, value := range values {
+		typ := pass.TypesInfo.TypeOf(value)
+		if typ == nil || types.IsInterface(typ) {
+			continue
+		}
+
+		rendered := renderNode(pass.Fset, value)
+		var fn, args string
+		switch {
+		case isKubernetesObject(typ) && hasMethod(typ, "GetName") && hasMethod(typ, "GetNamespace"):
+			fn, args = "KObj", rendered
+		case isKubernetesObject(typ):
+			// The methods of metav1.ObjectMeta have pointer receivers, the fields are promoted though.
+			fn, args = "KRef", rendered+".Namespace, "+rendered+".Name"
+		case isKubernetesObjectSlice(typ):
+			fn, args = "KObjSlice", rendered
+		default:
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:      value.Pos(),
+			End:      value.End(),
+			Category: DiagnosticCategory,
+			Message: fmt.Sprintf("Kubernetes object of type %s is logged in full, log its reference with klog.%s",
+				types.TypeString(typ, types.RelativeTo(pass.Pkg)), fn),
+		}
+		if isPlainOperand(value) {
+			diag.SuggestedFixes = wrapKlogFixes(pass, value, fn, args)
+		}
+		pass.Report(diag)
+	}
+}
+
+// isKubernetesObject returns true if the type, or the type it points to, embeds metav1.ObjectMeta
+// or implements metav1.Object.
+func isKubernetesObject(typ types.Type) bool {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	if isMetav1Type(named, "ObjectMeta") {
+		return false // the metadata alone, not an object
+	}
+
+	return embedsObjectMeta(named) || implementsMetav1Object(named)
+}
+
+// embedsObjectMeta returns true if the struct type embeds metav1.ObjectMeta or a pointer to it.
+func embedsObjectMeta(named *types.Named) bool {
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if !field.Embedded() {
+			continue
+		}
+
+		fieldType := field.Type()
+		if ptr, ok := fieldType.(*types.Pointer); ok {
+			fieldType = ptr.Elem()
+		}
+		if fieldNamed, ok := types.Unalias(fieldType).(*types.Named); ok && isMetav1Type(fieldNamed, "ObjectMeta") {
+			return true
+		}
+	}
+	return false
+}
+
+// implementsMetav1Object returns true if the type or a pointer to it implements metav1.Object.
+// Such types refer to the metav1 package in their method signatures, like GetUID, so the package
+// is imported by the package declaring the type.
+func implementsMetav1Object(named *types.Named) bool {
+	for _, imported := range named.Obj().Pkg().Imports() {
+		if rules.VendorLessPath(imported.Path()) != metav1Path {
+			continue
+		}
+
+		obj := imported.Scope().Lookup("Object")
+		if obj == nil {
+			return false
+		}
+		iface, ok := obj.Type().Underlying().(*types.Interface)
+		return ok && (types.Implements(named, iface) || types.Implements(types.NewPointer(named), iface))
+	}
+	return false
+}
+
+// isKubernetesObjectSlice returns true if the type is a slice of Kubernetes objects klog.KObjSlice accepts.
+func isKubernetesObjectSlice(typ types.Type) bool {
+	slice, ok := typ.Underlying().(*types.Slice)
+	if !ok {
+		return false
+	}
+
+	elem := slice.Elem()
+	return isKubernetesObject(elem) && hasMethod(elem, "GetName") && hasMethod(elem, "GetNamespace")
+}
+
+func isMetav1Type(named *types.Named, name string) bool {
+	obj := named.Obj()
+	return obj.Name() == name && obj.Pkg() != nil && rules.VendorLessPath(obj.Pkg().Path()) == metav1Path
+}
+
+// isPlainOperand returns true if the expression can be evaluated twice, like pod or c.pod.
+func isPlainOperand(expr ast.Expr) bool {
+	switch expr := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return true
+	case *ast.SelectorExpr:
+		return isPlainOperand(expr.X)
+	default:
+		return false
+	}
+}
+
+// wrapKlogFixes wraps the value with the klog function call, klog is imported if the file does not import it yet.
+func wrapKlogFixes(pass *analysis.Pass, value ast.Expr, fn, args string) []analysis.SuggestedFix {
+	file := fileOf(pass, value.Pos())
+	if file == nil {
+		return nil
+	}
+
+	name, imported := importedName(file, klogPath, "klog")
+	edits := []analysis.TextEdit{
+		{Pos: value.Pos(), End: value.End(), NewText: []byte(name + "." + fn + "(" + args + ")")},
+	}
+	if !imported {
+		edit, ok := addImportEdit(file, klogPath)
+		if !ok {
+			return nil
+		}
+		edits = append(edits, edit)
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message:   fmt.Sprintf("Log the reference with %s.%s", name, fn),
+			TextEdits: edits,
+		},
+	}
+}
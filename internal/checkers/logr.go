@@ -0,0 +1,226 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+type Logr struct {
+	General
+}
+
+// ReservedKeys returns the implicit "error" key, which logr sinks add for the error passed to Error.
+func (l Logr) ReservedKeys(fn *types.Func) []string {
+	if fn.Name() == "Error" {
+		return []string{"error"}
+	}
+	return nil
+}
+
+// CheckKubernetesObjects reports Kubernetes objects, the loggers of Kubernetes components are logr loggers.
+func (l Logr) CheckKubernetesObjects(pass *analysis.Pass, values []ast.Expr) {
+	checkKubernetesObjects(pass, values)
+}
+
+// CheckVerbosity reports V levels which are not constant or exceed maxLevel, if maxLevel is positive.
+// V levels are additive: logger.V(1).V(2) logs at level 3.
+func (l Logr) CheckVerbosity(pass *analysis.Pass, call CallContext, maxLevel int) {
+	if call.Func.Name() != "V" || len(call.Expr.Args) != 1 {
+		return
+	}
+
+	arg := call.Expr.Args[0]
+	var message string
+	level, ok := extractValueFromIntArg(pass, arg)
+	switch {
+	case !ok:
+		if maxLevel <= 0 {
+			return
+		}
+		message = fmt.Sprintf("V is called with a non-constant level, it cannot be checked against the maximal level %d",
+			maxLevel)
+	case maxLevel > 0 && level >= 0:
+		base, ok := l.baseLevel(pass, call)
+		if !ok || base > int64(maxLevel) || base+level <= int64(maxLevel) {
+			return // unknown or already reported by the receiver
+		}
+		message = fmt.Sprintf("V level %d exceeds the maximal level %d", base+level, maxLevel)
+	default:
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      arg.Pos(),
+		End:      arg.End(),
+		Category: DiagnosticCategory,
+		Message:  message,
+	})
+}
+
+// baseLevel returns the V level of the logger V is called on, for example 1 for logger.V(1).V(2).
+// ok is false if any of the levels is not constant.
+func (l Logr) baseLevel(pass *analysis.Pass, call CallContext) (level int64, ok bool) {
+	fun := call.Expr.Fun
+	for {
+		sel, isSel := ast.Unparen(fun).(*ast.SelectorExpr)
+		if !isSel {
+			return level, true
+		}
+		inner, isCall := ast.Unparen(sel.X).(*ast.CallExpr)
+		if !isCall {
+			return level, true
+		}
+		fn, _ := typeutil.Callee(pass.TypesInfo, inner).(*types.Func)
+		if fn == nil || fn.FullName() != call.Func.FullName() || len(inner.Args) != 1 {
+			return level, true
+		}
+
+		innerLevel, isConst := extractValueFromIntArg(pass, inner.Args[0])
+		if !isConst {
+			return 0, false
+		}
+		level += max(innerLevel, 0)
+		fun = inner.Fun
+	}
+}
+
+// CheckLibraryCall reports nil errors passed to Error, names passed to WithName which are empty or not made of
+// letters, digits and hyphens as recommended by logr, negative V levels which logr treats as 0, and negative
+// verbosity of funcr and testr loggers.
+func (l Logr) CheckLibraryCall(pass *analysis.Pass, call CallContext, _ []ast.Expr) {
+	switch call.Func.Name() {
+	case "V":
+		l.checkNegativeLevel(pass, call)
+	case "Error":
+		l.checkNilError(pass, call)
+	case "WithName":
+		if len(call.Expr.Args) == 1 {
+			checkLoggerName(pass, call.Func.Name(), call.Expr.Args[0])
+		}
+	case "New", "NewJSON", "NewWithOptions", "NewWithInterface":
+		l.checkOptionsVerbosity(pass, call)
+	}
+}
+
+// checkNegativeLevel reports V called with a negative constant level.
+func (l Logr) checkNegativeLevel(pass *analysis.Pass, call CallContext) {
+	if len(call.Expr.Args) != 1 {
+		return
+	}
+
+	arg := call.Expr.Args[0]
+	level, ok := extractValueFromIntArg(pass, arg)
+	if !ok || level >= 0 {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      arg.Pos(),
+		End:      arg.End(),
+		Category: DiagnosticCategory,
+		Message:  fmt.Sprintf("V is called with negative level %d, logr treats it as level 0", level),
+	})
+}
+
+// checkNilError reports Error called without an error, the policy is to log such messages with Info.
+func (l Logr) checkNilError(pass *analysis.Pass, call CallContext) {
+	if call.Signature.Recv() == nil || len(call.Expr.Args) < 2 {
+		return
+	}
+
+	arg := call.Expr.Args[0]
+	if tv, ok := pass.TypesInfo.Types[arg]; !ok || !tv.IsNil() {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:      arg.Pos(),
+		End:      arg.End(),
+		Category: DiagnosticCategory,
+		Message:  "Error is called with a nil error, use Info for messages without an error",
+	}
+	if sel, ok := ast.Unparen(call.Expr.Fun).(*ast.SelectorExpr); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{
+			{
+				Message: "Use Info",
+				TextEdits: []analysis.TextEdit{
+					{Pos: sel.Sel.Pos(), End: sel.Sel.End(), NewText: []byte("Info")},
+					{Pos: arg.Pos(), End: call.Expr.Args[1].Pos()},
+				},
+			},
+		}
+	}
+	pass.Report(diag)
+}
+
+// checkOptionsVerbosity reports negative verbosity in the options of funcr and testr loggers, like
+// funcr.Options{Verbosity: -1}: the loggers drop all the info messages then.
+func (l Logr) checkOptionsVerbosity(pass *analysis.Pass, call CallContext) {
+	for _, arg := range call.Expr.Args {
+		lit, ok := ast.Unparen(arg).(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+		named, ok := types.Unalias(pass.TypesInfo.TypeOf(lit)).(*types.Named)
+		if !ok || named.Obj().Name() != "Options" {
+			continue
+		}
+
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			if key, ok := kv.Key.(*ast.Ident); !ok || key.Name != "Verbosity" {
+				continue
+			}
+			if level, ok := extractValueFromIntArg(pass, kv.Value); ok && level < 0 {
+				pass.Report(analysis.Diagnostic{
+					Pos:      kv.Value.Pos(),
+					End:      kv.Value.End(),
+					Category: DiagnosticCategory,
+					Message: fmt.Sprintf("%s.Options has negative verbosity %d, the logger drops all the info messages",
+						named.Obj().Pkg().Name(), level),
+				})
+			}
+		}
+	}
+}
+
+// checkLoggerName reports logger names which are empty, or not made of letters, digits and hyphens.
+// Names are joined with "/" by logr sinks, so the segments are kept simple.
+func checkLoggerName(pass *analysis.Pass, fnName string, arg ast.Expr) {
+	name, ok := extractValueFromStringArg(pass, arg)
+	if !ok {
+		return
+	}
+
+	var message string
+	switch {
+	case name == "":
+		message = fmt.Sprintf("%s is called with an empty name, it adds an empty element to the logger name", fnName)
+	case strings.IndexFunc(name, isInvalidNameRune) >= 0:
+		message = fmt.Sprintf("logger name %q should only contain letters, digits and hyphens", name)
+	default:
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      arg.Pos(),
+		End:      arg.End(),
+		Category: DiagnosticCategory,
+		Message:  message,
+	})
+}
+
+func isInvalidNameRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-'
+}
+
+var _ Checker = (*Logr)(nil)
@@ -0,0 +1,317 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/timonwong/loggercheck/internal/checkers/printf"
+	"github.com/timonwong/loggercheck/internal/msgstyle"
+	"github.com/timonwong/loggercheck/internal/rules"
+)
+
+// MessageArg returns the message argument of the call, as declared by the matched rule.
+func MessageArg(call CallContext) (ast.Expr, bool) {
+	if call.MessageIndex < 0 || call.MessageIndex >= len(call.Expr.Args) {
+		return nil, false
+	}
+	return call.Expr.Args[call.MessageIndex], true
+}
+
+func (g General) CheckConstantMessage(pass *analysis.Pass, call CallContext, msg ast.Expr) {
+	if _, ok := extractValueFromStringArg(pass, msg); ok {
+		return
+	}
+
+	text, values, how, ok := splitDynamicMessage(pass, msg)
+	if !ok {
+		pass.Report(analysis.Diagnostic{
+			Pos:      msg.Pos(),
+			End:      msg.End(),
+			Category: DiagnosticCategory,
+			Message:  "logging message should be a constant string",
+		})
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      msg.Pos(),
+		End:      msg.End(),
+		Category: DiagnosticCategory,
+		Message: fmt.Sprintf("logging message should be a constant string, it is %s: "+
+			"pass the variable parts as key-value pairs instead", how),
+		SuggestedFixes: constantMessageFixes(pass, call, msg, text, values),
+	})
+}
+
+func (g General) CheckMessageStyle(pass *analysis.Pass, msg ast.Expr, style *msgstyle.Style) {
+	text, ok := extractValueFromStringArg(pass, msg)
+	if !ok {
+		return
+	}
+
+	fixed := false
+	for _, violation := range style.Check(text) {
+		diag := analysis.Diagnostic{
+			Pos:      msg.Pos(),
+			End:      msg.End(),
+			Category: DiagnosticCategory,
+			Message:  violation.Message,
+		}
+
+		// All the fixable violations are fixed at once, since the fixes would conflict otherwise.
+		if _, isLit := ast.Unparen(msg).(*ast.BasicLit); isLit && violation.Fixable && !fixed {
+			quoted := strconv.Quote(style.Fix(text))
+			diag.SuggestedFixes = []analysis.SuggestedFix{
+				{
+					Message: fmt.Sprintf("Use message %s", quoted),
+					TextEdits: []analysis.TextEdit{
+						{Pos: msg.Pos(), End: msg.End(), NewText: []byte(quoted)},
+					},
+				},
+			}
+			fixed = true
+		}
+		pass.Report(diag)
+	}
+}
+
+func (g General) CheckMessageKeyValues(pass *analysis.Pass, call CallContext, msg ast.Expr) {
+	text, values, ok := messageFormat(pass, msg)
+	if !ok {
+		return
+	}
+
+	fields := printf.FindEmbeddedFields(text)
+	fixes := embeddedFieldsFixes(pass, call, msg, text, values, fields)
+	for _, field := range fields {
+		pass.Report(analysis.Diagnostic{
+			Pos:      msg.Pos(),
+			End:      msg.End(),
+			Category: DiagnosticCategory,
+			Message: fmt.Sprintf("logging message contains key-value text %q, pass %q as a key-value pair instead",
+				field.Text(text), field.Key),
+			SuggestedFixes: fixes,
+		})
+		// All the fields are moved at once, since the fixes would conflict otherwise.
+		fixes = nil
+	}
+}
+
+// messageFormat returns the text of constant messages, or the format and the arguments of messages
+// formatted with fmt.Sprintf.
+func messageFormat(pass *analysis.Pass, msg ast.Expr) (format string, values []ast.Expr, ok bool) {
+	if text, ok := extractValueFromStringArg(pass, msg); ok {
+		return text, nil, true
+	}
+
+	sprintf, ok := ast.Unparen(msg).(*ast.CallExpr)
+	if !ok || len(sprintf.Args) == 0 || sprintf.Ellipsis.IsValid() {
+		return "", nil, false
+	}
+	if fn, _ := typeutil.Callee(pass.TypesInfo, sprintf).(*types.Func); fn == nil || fn.FullName() != "fmt.Sprintf" {
+		return "", nil, false
+	}
+
+	format, ok = extractValueFromStringArg(pass, sprintf.Args[0])
+	if !ok {
+		return "", nil, false
+	}
+	return format, sprintf.Args[1:], true
+}
+
+// embeddedFieldsFixes moves the fields written in a formatted message into key-value pairs.
+// The fix is only offered if every argument of the format is the value of a field,
+// fields with constant values are left in the message since their type is unknown.
+func embeddedFieldsFixes(
+	pass *analysis.Pass, call CallContext, msg ast.Expr, format string, values []ast.Expr, fields []printf.EmbeddedField,
+) []analysis.SuggestedFix {
+	if len(values) == 0 {
+		return nil
+	}
+
+	var (
+		rest  strings.Builder
+		keys  []string
+		start int
+	)
+	for _, field := range fields {
+		if field.Verb == "" {
+			continue
+		}
+		if strings.Contains(field.Verb, "[") || strings.Contains(field.Verb, "*") {
+			return nil // explicit argument indexes and star widths do not map to a single argument
+		}
+		rest.WriteString(format[start:field.Start])
+		start = field.End
+		keys = append(keys, field.Key)
+	}
+	rest.WriteString(format[start:])
+
+	text, nargs, ok := printf.StripVerbs(rest.String())
+	if !ok || nargs != 0 || len(keys) != len(values) {
+		return nil
+	}
+	return keyValueMessageFixes(pass, call, msg, text, keys, values)
+}
+
+// splitDynamicMessage splits messages like fmt.Sprintf("user %s failed", u) or "user " + u + " failed"
+// into the constant text and the values.
+func splitDynamicMessage(pass *analysis.Pass, msg ast.Expr) (text string, values []ast.Expr, how string, ok bool) {
+	switch msg := ast.Unparen(msg).(type) {
+	case *ast.CallExpr:
+		fn, _ := typeutil.Callee(pass.TypesInfo, msg).(*types.Func)
+		if fn == nil || fn.FullName() != "fmt.Sprintf" || len(msg.Args) == 0 || msg.Ellipsis.IsValid() {
+			return "", nil, "", false
+		}
+
+		format, ok := extractValueFromStringArg(pass, msg.Args[0])
+		if !ok {
+			return "", nil, "", false
+		}
+
+		text, nargs, ok := printf.StripVerbs(format)
+		if !ok || nargs != len(msg.Args)-1 {
+			return "", nil, "", false
+		}
+		return text, msg.Args[1:], "formatted with fmt.Sprintf", true
+	case *ast.BinaryExpr:
+		if msg.Op != token.ADD {
+			return "", nil, "", false
+		}
+
+		var parts []string
+		for _, operand := range concatOperands(msg) {
+			if s, ok := extractValueFromStringArg(pass, operand); ok {
+				parts = append(parts, s)
+			} else {
+				parts = append(parts, " ")
+				values = append(values, operand)
+			}
+		}
+		return strings.Join(parts, ""), values, "built by concatenation", true
+	}
+
+	return "", nil, "", false
+}
+
+// concatOperands flattens the string concatenation a + b + c.
+func concatOperands(expr ast.Expr) []ast.Expr {
+	if binary, ok := ast.Unparen(expr).(*ast.BinaryExpr); ok && binary.Op == token.ADD {
+		return append(concatOperands(binary.X), concatOperands(binary.Y)...)
+	}
+	return []ast.Expr{expr}
+}
+
+// constantMessageFixes replaces the message with the constant text and appends the values as key-value pairs,
+// keys are named after the variables or fields. No fix is offered if the keys cannot be named.
+func constantMessageFixes(
+	pass *analysis.Pass, call CallContext, msg ast.Expr, text string, values []ast.Expr,
+) []analysis.SuggestedFix {
+	keys := make([]string, 0, len(values))
+	for _, value := range values {
+		switch value := ast.Unparen(value).(type) {
+		case *ast.Ident:
+			keys = append(keys, value.Name)
+		case *ast.SelectorExpr:
+			keys = append(keys, value.Sel.Name)
+		default:
+			return nil
+		}
+	}
+	return keyValueMessageFixes(pass, call, msg, text, keys, values)
+}
+
+// keyValueMessageFixes replaces the message with the constant text and appends the values as key-value pairs,
+// or as fields like slog.Any("key", v) if the logger only accepts strongly-typed fields. The imports only used
+// by the message, like fmt for fmt.Sprintf, are removed.
+func keyValueMessageFixes(
+	pass *analysis.Pass, call CallContext, msg ast.Expr, text string, keys []string, values []ast.Expr,
+) []analysis.SuggestedFix {
+	text = strings.TrimRight(strings.Join(strings.Fields(text), " "), " :=,")
+	if text == "" {
+		return nil
+	}
+
+	file := fileOf(pass, msg.Pos())
+	if file == nil || !call.Signature.Variadic() || call.Expr.Ellipsis.IsValid() {
+		return nil // the values cannot be appended
+	}
+
+	_, keyValues, ok := variadicArgs(call)
+	if !ok {
+		return nil
+	}
+	fieldFunc := ""
+	if !keyValues {
+		if fieldFunc, ok = anyFieldFunc(file, call); !ok {
+			return nil
+		}
+	}
+
+	pairs := &strings.Builder{}
+	seen := make(map[string]bool)
+	for i, key := range keys {
+		if seen[key] {
+			return nil
+		}
+		seen[key] = true
+		if fieldFunc != "" {
+			fmt.Fprintf(pairs, ", %s(%q, %s)", fieldFunc, key, renderNode(pass.Fset, values[i]))
+		} else {
+			fmt.Fprintf(pairs, ", %q, %s", key, renderNode(pass.Fset, values[i]))
+		}
+	}
+
+	quoted := strconv.Quote(text)
+	lastArg := call.Expr.Args[len(call.Expr.Args)-1]
+	edits := []analysis.TextEdit{
+		{Pos: msg.Pos(), End: msg.End(), NewText: []byte(quoted)},
+		{Pos: lastArg.End(), End: lastArg.End(), NewText: []byte(pairs.String())},
+	}
+	if lastArg == msg {
+		edits = []analysis.TextEdit{
+			{Pos: msg.Pos(), End: msg.End(), NewText: []byte(quoted + pairs.String())},
+		}
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message:   fmt.Sprintf("Use constant message %s", quoted),
+			TextEdits: append(edits, unusedImportEdits(pass, file, msg)...),
+		},
+	}
+}
+
+// anyFieldFunc returns the constructor of fields of any value for loggers accepting strongly-typed fields only,
+// like slog.Any for ...slog.Attr and zap.Any for ...zap.Field. ok is false if the package is not imported.
+func anyFieldFunc(file *ast.File, call CallContext) (string, bool) {
+	params := call.Signature.Params()
+	slice, ok := params.At(params.Len() - 1).Type().(*types.Slice)
+	if !ok {
+		return "", false
+	}
+	named, ok := types.Unalias(slice.Elem()).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return "", false
+	}
+
+	var path, defaultName string
+	switch fieldType := rules.VendorLessPath(named.Obj().Pkg().Path()) + "." + named.Obj().Name(); fieldType {
+	case "log/slog.Attr":
+		path, defaultName = "log/slog", "slog"
+	case "go.uber.org/zap/zapcore.Field":
+		path, defaultName = "go.uber.org/zap", "zap"
+	default:
+		return "", false
+	}
+
+	name, imported := importedName(file, path, defaultName)
+	return name + ".Any", imported
+}
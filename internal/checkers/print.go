@@ -0,0 +1,225 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/timonwong/loggercheck/internal/checkers/printf"
+	"github.com/timonwong/loggercheck/internal/rules"
+)
+
+// keyLikePattern matches constant strings which look like logging keys rather than message text.
+var keyLikePattern = regexp.MustCompile(`^[A-Za-z_][0-9A-Za-z_.\-]*$`)
+
+// checkPrintKeyValues reports key-value pairs passed to functions formatting their arguments,
+// for example `sugar.Info("msg", "k", v)` or `sugar.Infof("msg %s", x, "k", v)` instead of `sugar.Infow`.
+// It returns true if the call is reported.
+func checkPrintKeyValues(c Checker, pass *analysis.Pass, call CallContext) bool {
+	keyAndValues, fixable, ok := formattedExtraArgs(pass, call)
+	if !ok || !looksLikeKeyValues(pass, keyAndValues) {
+		return false
+	}
+
+	name := call.Func.Name()
+	message := fmt.Sprintf("arguments of %s look like key-value pairs, but they are formatted", name)
+	if call.Kind == rules.Printf {
+		message = fmt.Sprintf("arguments of %s look like key-value pairs, but they are extra arguments of the format", name)
+	}
+
+	variant := c.StructuredVariant(call.Func)
+	if variant != "" {
+		message += fmt.Sprintf(": use %s instead", variant)
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:      keyAndValues[0].Pos(),
+		End:      keyAndValues[len(keyAndValues)-1].End(),
+		Category: DiagnosticCategory,
+		Message:  message,
+	}
+
+	if sel, ok := call.Expr.Fun.(*ast.SelectorExpr); ok && variant != "" && fixable {
+		diag.SuggestedFixes = []analysis.SuggestedFix{
+			{
+				Message: fmt.Sprintf("Use %s", variant),
+				TextEdits: []analysis.TextEdit{
+					{Pos: sel.Sel.Pos(), End: sel.Sel.End(), NewText: []byte(variant)},
+				},
+			},
+		}
+	}
+	pass.Report(diag)
+	return true
+}
+
+// formattedExtraArgs returns the arguments following the message of print-like functions, or following
+// the arguments of the format of printf-like functions. fixable is true if the message or format is kept as
+// the message of the structured variant.
+func formattedExtraArgs(pass *analysis.Pass, call CallContext) (extra []ast.Expr, fixable, ok bool) {
+	args := call.Expr.Args
+	switch call.Kind {
+	case rules.Print, rules.Println:
+		// The first formatted argument is the message, it may follow other params like the level.
+		start := call.Signature.Params().Len() - 1
+		if start < 0 || start >= len(args) {
+			return nil, false, false
+		}
+		if basic, isBasic := pass.TypesInfo.TypeOf(args[start]).Underlying().(*types.Basic); !isBasic || basic.Info()&types.IsString == 0 {
+			return nil, false, false
+		}
+		return args[start+1:], true, true
+	case rules.Printf:
+		if call.MessageIndex < 0 || call.MessageIndex >= len(args) {
+			return nil, false, false
+		}
+		format, isConst := extractValueFromStringArg(pass, args[call.MessageIndex])
+		if !isConst {
+			return nil, false, false
+		}
+		_, nargs, valid := printf.StripVerbs(format)
+		if !valid || call.MessageIndex+1+nargs > len(args) {
+			return nil, false, false
+		}
+		return args[call.MessageIndex+1+nargs:], nargs == 0, true
+	default:
+		return nil, false, false
+	}
+}
+
+// looksLikeKeyValues returns true if the arguments are pairs of constant keys and values, and not constant
+// strings only: these are more likely parts of the message.
+func looksLikeKeyValues(pass *analysis.Pass, args []ast.Expr) bool {
+	if len(args) == 0 || len(args)%2 != 0 {
+		return false
+	}
+
+	onlyText := true
+	for i := 0; i < len(args); i += 2 {
+		key, ok := extractValueFromStringArg(pass, args[i])
+		if !ok || !keyLikePattern.MatchString(key) {
+			return false
+		}
+		if _, ok := extractValueFromStringArg(pass, args[i+1]); !ok {
+			onlyText = false
+		}
+	}
+	return !onlyText
+}
+
+// checkPrintfCall checks the arguments of printf-like functions against their format: the number of arguments,
+// their types, and the %w directive, which is only supported by fmt.Errorf. Extra arguments are not reported
+// if they are already reported as key-value pairs.
+func checkPrintfCall(pass *analysis.Pass, call CallContext, extraReported bool) {
+	args := call.Expr.Args
+	if call.MessageIndex < 0 || call.MessageIndex >= len(args) {
+		return
+	}
+
+	formatArg := args[call.MessageIndex]
+	format, ok := extractValueFromStringArg(pass, formatArg)
+	if !ok {
+		return
+	}
+
+	name := call.Func.Name()
+	directives, ok := printf.ParseDirectives(format)
+	reportWrapDirectives(pass, name, formatArg, format, directives)
+	for _, d := range directives {
+		if d.Verb != 'w' && !d.Valid() {
+			pass.Report(analysis.Diagnostic{
+				Pos:      formatArg.Pos(),
+				End:      formatArg.End(),
+				Category: DiagnosticCategory,
+				Message:  fmt.Sprintf("%s format %s has unknown verb or flags", name, d.Format),
+			})
+			ok = false
+		}
+	}
+	if !ok {
+		return // arguments of the directives are unknown
+	}
+
+	fmtArgs := args[call.MessageIndex+1:]
+	qf := types.RelativeTo(pass.Pkg)
+	for i, d := range directives {
+		if i >= len(fmtArgs) {
+			pass.Report(analysis.Diagnostic{
+				Pos:      formatArg.Pos(),
+				End:      formatArg.End(),
+				Category: DiagnosticCategory,
+				Message:  fmt.Sprintf("%s format %s reads arg #%d, but call has %d args", name, d.Format, i+1, len(fmtArgs)),
+			})
+			return
+		}
+
+		arg := fmtArgs[i]
+		if typ := pass.TypesInfo.TypeOf(arg); d.Verb != 'w' && !printf.MatchArgType(d.Verb, typ) {
+			pass.Report(analysis.Diagnostic{
+				Pos:      arg.Pos(),
+				End:      arg.End(),
+				Category: DiagnosticCategory,
+				Message: fmt.Sprintf("%s format %s has arg %s of wrong type %s",
+					name, d.Format, renderNodeEllipsis(pass.Fset, arg), types.TypeString(typ, qf)),
+			})
+		}
+	}
+
+	if len(fmtArgs) > len(directives) && !extraReported {
+		extra := fmtArgs[len(directives)]
+		pass.Report(analysis.Diagnostic{
+			Pos:      extra.Pos(),
+			End:      fmtArgs[len(fmtArgs)-1].End(),
+			Category: DiagnosticCategory,
+			Message:  fmt.Sprintf("%s call needs %d args but has %d args", name, len(directives), len(fmtArgs)),
+		})
+	}
+}
+
+// reportWrapDirectives reports the %w directives, the fix replaces them with %v in string literals.
+// All the directives are replaced by the fix of the first one, since the fixes would conflict otherwise.
+func reportWrapDirectives(pass *analysis.Pass, name string, formatArg ast.Expr, format string, directives []printf.Directive) {
+	var (
+		fixed strings.Builder
+		start int
+		wraps []printf.Directive
+	)
+	for _, d := range directives {
+		if d.Verb != 'w' {
+			continue
+		}
+		fixed.WriteString(format[start:d.Offset])
+		fixed.WriteString(strings.TrimSuffix(d.Format, "w") + "v")
+		start = d.Offset + len(d.Format)
+		wraps = append(wraps, d)
+	}
+	fixed.WriteString(format[start:])
+
+	var fixes []analysis.SuggestedFix
+	if lit, ok := ast.Unparen(formatArg).(*ast.BasicLit); ok {
+		fixes = []analysis.SuggestedFix{
+			{
+				Message: "Use %v",
+				TextEdits: []analysis.TextEdit{
+					{Pos: lit.Pos(), End: lit.End(), NewText: []byte(strconv.Quote(fixed.String()))},
+				},
+			},
+		}
+	}
+
+	for _, d := range wraps {
+		pass.Report(analysis.Diagnostic{
+			Pos:            formatArg.Pos(),
+			End:            formatArg.End(),
+			Category:       DiagnosticCategory,
+			Message:        fmt.Sprintf("%s does not support error-wrapping directive %s, use %%v instead", name, d.Format),
+			SuggestedFixes: fixes,
+		})
+		fixes = nil
+	}
+}
@@ -0,0 +1,76 @@
+package printf
+
+import (
+	"regexp"
+	"strings"
+)
+
+// embeddedKeyPattern matches the keys of key-value pairs written in message text: "user=" and "user:".
+var embeddedKeyPattern = regexp.MustCompile(`(?:^|[\s,;(\[])([A-Za-z_][0-9A-Za-z_.\-]*)([=:])`)
+
+// EmbeddedField is a key-value pair written in the message text instead of being passed as
+// key-value arguments, for example: "request failed user=%s" or "done, took:".
+type EmbeddedField struct {
+	Key   string
+	Start int    // byte offset of the key in the message
+	End   int    // byte offset after the value
+	Verb  string // the format directive of the value, empty if the value is not a directive
+}
+
+// Text returns the field as written in the message.
+func (f EmbeddedField) Text(msg string) string {
+	return strings.TrimSpace(msg[f.Start:f.End])
+}
+
+// FindEmbeddedFields returns the key-value pairs written in the message text: "key=value", "key: %v" and
+// a trailing "key:". Colons followed by plain text are not reported since they are common in sentences.
+func FindEmbeddedFields(msg string) []EmbeddedField {
+	var fields []EmbeddedField
+	for _, m := range embeddedKeyPattern.FindAllStringSubmatchIndex(msg, -1) {
+		field := EmbeddedField{
+			Key:   msg[m[2]:m[3]],
+			Start: m[2],
+		}
+		rest := msg[m[5]:]
+
+		if msg[m[4]] == '=' {
+			value := rest
+			if i := strings.IndexAny(rest, " \t\r\n,;)]"); i >= 0 {
+				value = rest[:i]
+			}
+			if value == "" || strings.HasPrefix(value, "=") {
+				continue // "a == b" and "key= " are not key-value pairs
+			}
+			field.Verb = directiveOf(value)
+			field.End = m[5] + len(value)
+			fields = append(fields, field)
+			continue
+		}
+
+		trimmed := strings.TrimLeft(rest, " ")
+		switch {
+		case strings.TrimSpace(trimmed) == "":
+			field.End = len(msg)
+		case directiveOf(trimmed) != "":
+			field.Verb = directiveOf(trimmed)
+			field.End = len(msg) - len(trimmed) + len(field.Verb)
+		default:
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// directiveOf returns the format directive the string starts with, like "%s" or "%.2f".
+func directiveOf(s string) string {
+	if !strings.HasPrefix(s, "%") {
+		return ""
+	}
+
+	state := parsePrintfVerb(s)
+	if state == nil || state.verb == '%' || !isPrintfArg(state) {
+		return ""
+	}
+	return state.format
+}
@@ -0,0 +1,48 @@
+package printf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindEmbeddedFields(t *testing.T) {
+	testCases := []struct {
+		msg  string
+		want []string // texts of the fields
+		keys []string
+	}{
+		{msg: "user logged in"},
+		{msg: "request failed user=%s", want: []string{"user=%s"}, keys: []string{"user"}},
+		{msg: "retrying attempt=3 of 5", want: []string{"attempt=3"}, keys: []string{"attempt"}},
+		{msg: "done, took: ", want: []string{"took:"}, keys: []string{"took"}},
+		{msg: "done, took:", want: []string{"took:"}, keys: []string{"took"}},
+		{msg: "copied bytes: %d, files=%d", want: []string{"bytes: %d", "files=%d"}, keys: []string{"bytes", "files"}},
+		{msg: "(pod.name=%q)", want: []string{"pod.name=%q"}, keys: []string{"pod.name"}},
+		{msg: "Error: connection refused"},
+		{msg: "see https://example.com"},
+		{msg: "check a == b"},
+		{msg: "progress: 100%%"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			var texts, keys []string
+			for _, field := range FindEmbeddedFields(tc.msg) {
+				texts = append(texts, field.Text(tc.msg))
+				keys = append(keys, field.Key)
+			}
+			assert.Equal(t, tc.want, texts)
+			assert.Equal(t, tc.keys, keys)
+		})
+	}
+}
+
+func TestFindEmbeddedFields_Verb(t *testing.T) {
+	fields := FindEmbeddedFields("user=%s took: %.2f count=3")
+	assert.Equal(t, []EmbeddedField{
+		{Key: "user", Start: 0, End: 7, Verb: "%s"},
+		{Key: "took", Start: 8, End: 18, Verb: "%.2f"},
+		{Key: "count", Start: 19, End: 26},
+	}, fields)
+}
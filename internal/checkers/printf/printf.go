@@ -12,8 +12,9 @@ import (
 // license that can be found in the LICENSE file.
 
 type printVerb struct {
-	verb  rune   // User may provide verb through Formatter; could be a rune.
-	flags string // known flags are all ASCII
+	verb  rune    // User may provide verb through Formatter; could be a rune.
+	flags string  // known flags are all ASCII
+	typ   argKind // which kinds of arguments are accepted by the verb
 }
 
 // Common flag sets for printf verbs.
@@ -24,35 +25,35 @@ const (
 	allFlags     = " -+.0#"
 )
 
-// printVerbs identifies which flags are known to printf for each verb.
+// printVerbs identifies which flags and argument kinds are known to printf for each verb.
 var printVerbs = []printVerb{
 	// '-' is a width modifier, always valid.
 	// '.' is a precision for float, max width for strings.
 	// '+' is required sign for numbers, Go format for %v.
 	// '#' is alternate format for several verbs.
 	// ' ' is spacer for numbers
-	{'%', noFlag},
-	{'b', sharpNumFlag},
-	{'c', "-"},
-	{'d', numFlag},
-	{'e', sharpNumFlag},
-	{'E', sharpNumFlag},
-	{'f', sharpNumFlag},
-	{'F', sharpNumFlag},
-	{'g', sharpNumFlag},
-	{'G', sharpNumFlag},
-	{'o', sharpNumFlag},
-	{'O', sharpNumFlag},
-	{'p', "-#"},
-	{'q', " -+.0#"},
-	{'s', " -+.0"},
-	{'t', "-"},
-	{'T', "-"},
-	{'U', "-#"},
-	{'v', allFlags},
-	{'w', allFlags},
-	{'x', sharpNumFlag},
-	{'X', sharpNumFlag},
+	{'%', noFlag, 0},
+	{'b', sharpNumFlag, argInt | argFloat | argComplex | argPointer},
+	{'c', "-", argRune | argInt},
+	{'d', numFlag, argInt | argPointer},
+	{'e', sharpNumFlag, argFloat | argComplex},
+	{'E', sharpNumFlag, argFloat | argComplex},
+	{'f', sharpNumFlag, argFloat | argComplex},
+	{'F', sharpNumFlag, argFloat | argComplex},
+	{'g', sharpNumFlag, argFloat | argComplex},
+	{'G', sharpNumFlag, argFloat | argComplex},
+	{'o', sharpNumFlag, argInt | argPointer},
+	{'O', sharpNumFlag, argInt | argPointer},
+	{'p', "-#", argPointer},
+	{'q', " -+.0#", argRune | argInt | argString},
+	{'s', " -+.0", argString},
+	{'t', "-", argBool},
+	{'T', "-", anyType},
+	{'U', "-#", argRune | argInt},
+	{'v', allFlags, anyType},
+	{'w', allFlags, argError},
+	{'x', sharpNumFlag, argRune | argInt | argString | argPointer | argFloat | argComplex},
+	{'X', sharpNumFlag, argRune | argInt | argString | argPointer | argFloat | argComplex},
 }
 
 // formatState holds the parsed representation of a printf directive such as "%3.*[4]d".
@@ -190,17 +191,19 @@ func containsAll(s string, pattern []byte) bool {
 	return true
 }
 
-func isPrintfArg(state *formatState) bool {
-	var v printVerb
-	found := false
+// lookupVerb returns the known flags and argument kinds of the verb.
+func lookupVerb(verb rune) (printVerb, bool) {
 	// Linear scan is fast enough for a small list.
-	for _, v = range printVerbs {
-		if v.verb == state.verb {
-			found = true
-			break
+	for _, v := range printVerbs {
+		if v.verb == verb {
+			return v, true
 		}
 	}
+	return printVerb{}, false
+}
 
+func isPrintfArg(state *formatState) bool {
+	v, found := lookupVerb(state.verb)
 	if !found {
 		// unknown verb, just skip
 		return false
@@ -243,3 +246,97 @@ func IsPrintfLike(format string) (firstSpecifier string, ok bool) {
 
 	return
 }
+
+// StripVerbs removes the format directives from the format, "%%" is replaced by "%". Directives consuming
+// an argument are removed with the text attached to them, up to the surrounding spaces: the text is part
+// of the formatted value, like the unit of "%dms" or the percent sign of "%d%%".
+// It returns the number of arguments the directives consume, ok is false if the format uses
+// explicit argument indexes, '*' widths or unknown verbs.
+func StripVerbs(format string) (text string, nargs int, ok bool) {
+	buf := &strings.Builder{}
+	word := &strings.Builder{}
+	hasArg := false // the word contains a directive consuming an argument
+	flush := func() {
+		if !hasArg {
+			buf.WriteString(word.String())
+		}
+		word.Reset()
+		hasArg = false
+	}
+
+	for i, w := 0, 0; i < len(format); i += w {
+		w = 1
+		switch format[i] {
+		case ' ', '\t', '\n':
+			flush()
+			buf.WriteByte(format[i])
+			continue
+		case '%':
+		default:
+			word.WriteByte(format[i])
+			continue
+		}
+
+		state := parsePrintfVerb(format[i:])
+		if state == nil || state.hasIndex || strings.Contains(state.format, "*") {
+			return "", 0, false
+		}
+
+		w = len(state.format)
+		if state.verb == '%' {
+			word.WriteByte('%')
+			continue
+		}
+		if !isPrintfArg(state) {
+			return "", 0, false
+		}
+		hasArg = true
+		nargs++
+	}
+	flush()
+
+	return buf.String(), nargs, true
+}
+
+// Directive is a format directive consuming an argument, like "%5.2f".
+type Directive struct {
+	Format string // the directive, from % through the verb
+	Verb   rune
+	Offset int // byte offset of the directive in the format
+
+	state *formatState
+}
+
+// Valid reports whether the verb and the flags of the directive are known.
+func (d Directive) Valid() bool {
+	return isPrintfArg(d.state)
+}
+
+// ParseDirectives returns the directives of the format, "%%" is skipped. The n-th directive formats
+// the n-th argument, ok is false if the format uses explicit argument indexes, '*' widths or bad syntax.
+func ParseDirectives(format string) (directives []Directive, ok bool) {
+	for i, w := 0, 0; i < len(format); i += w {
+		w = 1
+		if format[i] != '%' {
+			continue
+		}
+
+		state := parsePrintfVerb(format[i:])
+		if state == nil || state.hasIndex || strings.Contains(state.format, "*") {
+			return directives, false
+		}
+
+		w = len(state.format)
+		if state.verb == '%' {
+			continue
+		}
+		directives = append(directives, Directive{
+			Format: state.format,
+			Verb:   state.verb,
+			Offset: i,
+			state:  state,
+		})
+	}
+
+	return directives, true
+}
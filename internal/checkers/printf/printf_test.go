@@ -0,0 +1,70 @@
+package printf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripVerbs(t *testing.T) {
+	testCases := []struct {
+		format string
+		text   string
+		nargs  int
+		ok     bool
+	}{
+		{format: "user logged in", text: "user logged in", ok: true},
+		{format: "user %s failed", text: "user  failed", nargs: 1, ok: true},
+		{format: "%d/%d done, 100%%", text: " done, 100%", nargs: 2, ok: true},
+		{format: "took %.3fs", text: "took ", nargs: 1, ok: true},
+		{format: "loaded %d%%", text: "loaded ", nargs: 1, ok: true},
+		{format: "request %d: %v", text: "request  ", nargs: 2, ok: true},
+		{format: "%[1]s %[1]q"},
+		{format: "%*d"},
+		{format: "%!"},
+		{format: "trailing %"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			text, nargs, ok := StripVerbs(tc.format)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.text, text)
+			assert.Equal(t, tc.nargs, nargs)
+		})
+	}
+}
+
+func TestParseDirectives(t *testing.T) {
+	testCases := []struct {
+		format  string
+		formats []string
+		valid   []bool
+		ok      bool
+	}{
+		{format: "user logged in", ok: true},
+		{format: "user %s took %.2fs, 100%%", formats: []string{"%s", "%.2f"}, valid: []bool{true, true}, ok: true},
+		{format: "failed: %w", formats: []string{"%w"}, valid: []bool{true}, ok: true},
+		{format: "%z %#s", formats: []string{"%z", "%#s"}, valid: []bool{false, false}, ok: true},
+		{format: "%s %[1]q", formats: []string{"%s"}, valid: []bool{true}},
+		{format: "%*d"},
+		{format: "trailing %"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			directives, ok := ParseDirectives(tc.format)
+			assert.Equal(t, tc.ok, ok)
+
+			var formats []string
+			var valid []bool
+			for _, d := range directives {
+				formats = append(formats, d.Format)
+				valid = append(valid, d.Valid())
+				assert.Equal(t, d.Format, tc.format[d.Offset:d.Offset+len(d.Format)])
+			}
+			assert.Equal(t, tc.formats, formats)
+			assert.Equal(t, tc.valid, valid)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package printf
+
+import (
+	"go/types"
+)
+
+// Adapted from the printf checker of golang.org/x/tools
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+type argKind int
+
+const (
+	argBool argKind = 1 << iota
+	argInt
+	argRune
+	argFloat
+	argComplex
+	argString
+	argPointer
+	argError
+
+	anyType argKind = -1
+)
+
+// MatchArgType reports whether an argument of type typ can be formatted by the verb.
+// Interfaces are always accepted since their dynamic type is unknown, so are fmt.Formatter implementations.
+func MatchArgType(verb rune, typ types.Type) bool {
+	v, ok := lookupVerb(verb)
+	if !ok || v.typ == 0 {
+		return false
+	}
+	return matchArgType(v.typ, typ, true, make(map[types.Type]bool))
+}
+
+func matchArgType(kinds argKind, typ types.Type, topLevel bool, seen map[types.Type]bool) bool {
+	if kinds == anyType || typ == nil {
+		return true
+	}
+	if named, ok := typ.(*types.Named); ok {
+		if seen[named] {
+			return true // recursive type, checked already
+		}
+		seen[named] = true
+	}
+
+	if matchMethods(kinds, typ) {
+		return true
+	}
+
+	switch typ := typ.Underlying().(type) {
+	case *types.Interface:
+		return true
+	case *types.Basic:
+		return matchBasic(kinds, typ)
+	case *types.Pointer:
+		return matchPointer(kinds, typ, topLevel, seen)
+	case *types.Chan, *types.Signature:
+		return kinds&argPointer != 0
+	default:
+		return matchComposite(kinds, typ, seen)
+	}
+}
+
+// matchMethods reports whether the type formats itself with a Format, Error or String method accepted by the verb.
+func matchMethods(kinds argKind, typ types.Type) bool {
+	if hasMethod(typ, "Format") {
+		return true
+	}
+	if kinds&(argString|argError) != 0 && hasMethod(typ, "Error") {
+		return true
+	}
+	return kinds&argString != 0 && hasMethod(typ, "String")
+}
+
+func matchPointer(kinds argKind, typ *types.Pointer, topLevel bool, seen map[types.Type]bool) bool {
+	if kinds&argPointer != 0 {
+		return true
+	}
+	// Pointers to composite values are printed as &{...}, &[...] and map[...] at the top level.
+	switch typ.Elem().Underlying().(type) {
+	case *types.Struct, *types.Array, *types.Slice, *types.Map:
+		return topLevel && matchArgType(kinds, typ.Elem(), false, seen)
+	}
+	return false
+}
+
+// matchComposite reports whether the elements of slices, arrays and maps, or the fields of structs,
+// are accepted by the verb.
+func matchComposite(kinds argKind, typ types.Type, seen map[types.Type]bool) bool {
+	switch typ := typ.(type) {
+	case *types.Slice:
+		if kinds&argString != 0 && isByte(typ.Elem()) {
+			return true
+		}
+		return kinds&argPointer != 0 || matchArgType(kinds, typ.Elem(), false, seen)
+	case *types.Array:
+		if kinds&argString != 0 && isByte(typ.Elem()) {
+			return true
+		}
+		return matchArgType(kinds, typ.Elem(), false, seen)
+	case *types.Map:
+		return kinds&argPointer != 0 ||
+			(matchArgType(kinds, typ.Key(), false, seen) && matchArgType(kinds, typ.Elem(), false, seen))
+	case *types.Struct:
+		for i := 0; i < typ.NumFields(); i++ {
+			if !matchArgType(kinds, typ.Field(i).Type(), false, seen) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func matchBasic(kinds argKind, typ *types.Basic) bool {
+	switch info := typ.Info(); {
+	case typ.Kind() == types.UntypedNil:
+		return true
+	case typ.Kind() == types.UnsafePointer:
+		return kinds&argPointer != 0
+	case info&types.IsBoolean != 0:
+		return kinds&argBool != 0
+	case info&types.IsInteger != 0:
+		return kinds&(argInt|argRune) != 0
+	case info&types.IsFloat != 0:
+		return kinds&argFloat != 0
+	case info&types.IsComplex != 0:
+		return kinds&argComplex != 0
+	case info&types.IsString != 0:
+		return kinds&argString != 0
+	}
+	return false
+}
+
+func isByte(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Kind() == types.Byte
+}
+
+func hasMethod(typ types.Type, name string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, name)
+	_, ok := obj.(*types.Func)
+	return ok
+}
@@ -0,0 +1,54 @@
+package printf
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchArgType(t *testing.T) {
+	pkg := types.NewPackage("example.com/a", "a")
+	stringer := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "ID", nil), types.Typ[types.Int], nil)
+	stringer.AddMethod(types.NewFunc(token.NoPos, pkg, "String",
+		types.NewSignatureType(nil, nil, nil, nil, types.NewTuple(types.NewParam(token.NoPos, pkg, "", types.Typ[types.String])), false)))
+
+	point := types.NewStruct([]*types.Var{
+		types.NewField(token.NoPos, pkg, "X", types.Typ[types.Int], false),
+		types.NewField(token.NoPos, pkg, "Y", types.Typ[types.Int], false),
+	}, nil)
+	errorType := types.Universe.Lookup("error").Type()
+
+	testCases := []struct {
+		name string
+		verb rune
+		typ  types.Type
+		want bool
+	}{
+		{name: "d-int", verb: 'd', typ: types.Typ[types.Int], want: true},
+		{name: "d-string", verb: 'd', typ: types.Typ[types.String]},
+		{name: "s-string", verb: 's', typ: types.Typ[types.String], want: true},
+		{name: "s-int", verb: 's', typ: types.Typ[types.Int]},
+		{name: "s-bytes", verb: 's', typ: types.NewSlice(types.Typ[types.Byte]), want: true},
+		{name: "s-stringer", verb: 's', typ: stringer, want: true},
+		{name: "s-error", verb: 's', typ: errorType, want: true},
+		{name: "f-float", verb: 'f', typ: types.Typ[types.Float64], want: true},
+		{name: "f-int", verb: 'f', typ: types.Typ[types.Int]},
+		{name: "t-bool", verb: 't', typ: types.Typ[types.Bool], want: true},
+		{name: "v-anything", verb: 'v', typ: types.NewChan(types.SendRecv, types.Typ[types.Int]), want: true},
+		{name: "p-slice", verb: 'p', typ: types.NewSlice(types.Typ[types.Int]), want: true},
+		{name: "p-int", verb: 'p', typ: types.Typ[types.Int]},
+		{name: "d-struct", verb: 'd', typ: point, want: true},
+		{name: "d-pointer-to-struct", verb: 'd', typ: types.NewPointer(point), want: true},
+		{name: "s-struct", verb: 's', typ: point},
+		{name: "d-slice", verb: 'd', typ: types.NewSlice(types.Typ[types.Int]), want: true},
+		{name: "unknown-verb", verb: 'z', typ: types.Typ[types.Int]},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, MatchArgType(tc.verb, tc.typ))
+		})
+	}
+}
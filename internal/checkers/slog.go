@@ -1,9 +1,12 @@
 package checkers
 
 import (
+	"fmt"
 	"go/ast"
+	"go/types"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
 )
 
 type Slog struct {
@@ -16,4 +19,108 @@ func (z Slog) FilterKeyAndValues(pass *analysis.Pass, keyAndValues []ast.Expr) [
 	return filterKeyAndValues(pass, keyAndValues, "Attr")
 }
 
+func (z Slog) FieldKey(pass *analysis.Pass, arg ast.Expr) (Key, bool) {
+	return fieldKeyOf(pass, arg, "Attr")
+}
+
+func (z Slog) FieldKeyExpr(pass *analysis.Pass, arg ast.Expr) (ast.Expr, bool) {
+	return fieldKeyExprOf(pass, arg, "Attr")
+}
+
+// ReservedKeys returns the keys used by the built-in handlers, see slog.TimeKey and friends.
+func (z Slog) ReservedKeys(_ *types.Func) []string {
+	return []string{"time", "level", "msg", "source"}
+}
+
+// CheckReservedKeys reports keys colliding with the reserved keys, unless they are nested in a group.
+func (z Slog) CheckReservedKeys(pass *analysis.Pass, call CallContext, keys []Key, reservedKeys []string) {
+	if z.inGroup(pass, call) {
+		return
+	}
+	z.General.CheckReservedKeys(pass, call, keys, reservedKeys)
+}
+
+// inGroup returns true if the keys of the call are nested in a group: the keys passed to slog.Group,
+// and the keys logged by loggers derived with WithGroup, like logger.WithGroup("request").Info(...).
+func (z Slog) inGroup(pass *analysis.Pass, call CallContext) bool {
+	switch call.Func.Name() {
+	case "Group", "GroupAttrs":
+		return true
+	}
+
+	sel, ok := ast.Unparen(call.Expr.Fun).(*ast.SelectorExpr)
+	return ok && call.Signature.Recv() != nil && z.derivedFromGroup(pass, sel.X)
+}
+
+// derivedFromGroup returns true if the logger is derived with WithGroup, loggers stored in variables
+// are followed to the value they are declared with.
+func (z Slog) derivedFromGroup(pass *analysis.Pass, logger ast.Expr) bool {
+	switch logger := ast.Unparen(logger).(type) {
+	case *ast.CallExpr:
+		fn, _ := typeutil.Callee(pass.TypesInfo, logger).(*types.Func)
+		if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "log/slog" {
+			return false
+		}
+		if fn.Name() == "WithGroup" {
+			return true
+		}
+
+		sel, ok := ast.Unparen(logger.Fun).(*ast.SelectorExpr)
+		return ok && fn.Type().(*types.Signature).Recv() != nil && z.derivedFromGroup(pass, sel.X)
+	case *ast.Ident:
+		v, ok := pass.TypesInfo.Uses[logger].(*types.Var)
+		if !ok {
+			return false
+		}
+		init := initialValueOf(pass, v)
+		return init != nil && z.derivedFromGroup(pass, init)
+	default:
+		return false
+	}
+}
+
+func (z Slog) CheckStringifiedValues(pass *analysis.Pass, keyAndValues []ast.Expr, args []ast.Expr) {
+	z.General.CheckStringifiedValues(pass, keyAndValues, args)
+
+	for _, arg := range args {
+		field, ok := fieldConstructorOf(pass, arg, "log/slog")
+		if !ok || field.name != "String" || len(field.call.Args) != 2 {
+			continue
+		}
+
+		key, value := field.call.Args[0], field.call.Args[1]
+		if stringified, isError, ok := stringifiedValue(pass, value); ok {
+			field.replace(pass, stringifiedProblem(pass, value, isError), "Any", key, stringified)
+		}
+	}
+}
+
+// CheckLibraryCall reports empty group names: slog.Group and slog.GroupAttrs are inlined into the parent,
+// and WithGroup returns the receiver unchanged.
+func (z Slog) CheckLibraryCall(pass *analysis.Pass, call CallContext, _ []ast.Expr) {
+	var effect string
+	switch call.Func.Name() {
+	case "Group", "GroupAttrs":
+		effect = "the attributes are inlined into the parent group"
+	case "WithGroup":
+		effect = "the receiver is returned unchanged"
+	default:
+		return
+	}
+
+	if len(call.Expr.Args) == 0 {
+		return
+	}
+	if name, ok := extractValueFromStringArg(pass, call.Expr.Args[0]); !ok || name != "" {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      call.Expr.Args[0].Pos(),
+		End:      call.Expr.Args[0].End(),
+		Category: DiagnosticCategory,
+		Message:  fmt.Sprintf("%s is called with an empty group name, %s", call.Func.Name(), effect),
+	})
+}
+
 var _ Checker = (*Slog)(nil)
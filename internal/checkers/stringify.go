@@ -0,0 +1,187 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/timonwong/loggercheck/internal/rules"
+)
+
+// singleVerbFormat matches formats which only convert the argument to a string, like "%v" and "%d".
+var singleVerbFormat = regexp.MustCompile(`^%[vdstg]$`)
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// stringerType is the fmt.Stringer interface.
+var stringerType = types.NewInterfaceType([]*types.Func{
+	types.NewFunc(token.NoPos, nil, "String", types.NewSignatureType(nil, nil, nil, nil,
+		types.NewTuple(types.NewVar(token.NoPos, nil, "", types.Typ[types.String])), false)),
+}, nil).Complete()
+
+// stringifiedValue returns the value converted to a string by the expression: err.Error(), v.String()
+// or fmt.Sprintf("%v", v). isError is true if the value is an error.
+func stringifiedValue(pass *analysis.Pass, expr ast.Expr) (value ast.Expr, isError, ok bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return nil, false, false
+	}
+
+	fn, _ := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if fn == nil {
+		return nil, false, false
+	}
+
+	if fn.Pkg() != nil && fn.Pkg().Path() == "fmt" && fn.Name() == "Sprintf" {
+		return sprintfValue(pass, call)
+	}
+	return methodValue(pass, fn, call)
+}
+
+// sprintfValue returns the value formatted by fmt.Sprintf with a single verb, like fmt.Sprintf("%v", v).
+func sprintfValue(pass *analysis.Pass, call *ast.CallExpr) (value ast.Expr, isError, ok bool) {
+	if len(call.Args) != 2 {
+		return nil, false, false
+	}
+
+	format, ok := extractValueFromStringArg(pass, call.Args[0])
+	if !ok || !singleVerbFormat.MatchString(format) {
+		return nil, false, false
+	}
+	value = call.Args[1]
+	return value, types.Implements(pass.TypesInfo.TypeOf(value), errorType), true
+}
+
+// methodValue returns the receiver of the Error or String method called by the expression.
+// Methods are only taken into account if they are in the method set of the value itself, the value of
+// buf.String() with buf a bytes.Buffer is not a fmt.Stringer: String has a pointer receiver.
+func methodValue(pass *analysis.Pass, fn *types.Func, call *ast.CallExpr) (value ast.Expr, isError, ok bool) {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok || len(call.Args) != 0 {
+		return nil, false, false
+	}
+
+	selection := pass.TypesInfo.Selections[sel]
+	if selection == nil || selection.Kind() != types.MethodVal {
+		return nil, false, false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 0 || sig.Results().Len() != 1 || !isStringType(sig.Results().At(0).Type()) {
+		return nil, false, false
+	}
+
+	value = ast.Unparen(sel.X)
+	typ := pass.TypesInfo.TypeOf(value)
+	switch {
+	case fn.Name() == "Error" && types.Implements(typ, errorType):
+		return value, true, true
+	case fn.Name() == "String" && types.Implements(typ, stringerType):
+		return value, false, true
+	}
+	return nil, false, false
+}
+
+func isStringType(typ types.Type) bool {
+	basic, ok := typ.(*types.Basic)
+	return ok && basic.Kind() == types.String
+}
+
+// checkStringifiedKeyValues reports values of key-value pairs which are converted to strings,
+// the fix passes the value itself since any value is accepted.
+func checkStringifiedKeyValues(pass *analysis.Pass, keyAndValues []ast.Expr) {
+	for i := 1; i < len(keyAndValues); i += 2 {
+		arg := keyAndValues[i]
+		value, isError, ok := stringifiedValue(pass, arg)
+		if !ok {
+			continue
+		}
+
+		valueStr := renderNode(pass.Fset, value)
+		pass.Report(analysis.Diagnostic{
+			Pos:      arg.Pos(),
+			End:      arg.End(),
+			Category: DiagnosticCategory,
+			Message:  fmt.Sprintf("%s, pass %s instead", stringifiedProblem(pass, arg, isError), valueStr),
+			SuggestedFixes: []analysis.SuggestedFix{
+				{
+					Message: fmt.Sprintf("Pass %s", valueStr),
+					TextEdits: []analysis.TextEdit{
+						{Pos: arg.Pos(), End: arg.End(), NewText: []byte(valueStr)},
+					},
+				},
+			},
+		})
+	}
+}
+
+// fieldConstructor is a call to a field constructor with a package selector, for example: zap.String("key", v).
+type fieldConstructor struct {
+	call *ast.CallExpr
+	pkg  string // the package name used in the source
+	name string // the name of the constructor
+}
+
+// fieldConstructorOf returns the field constructor called in pkgPath, it must be called with a package selector
+// so it can be replaced.
+func fieldConstructorOf(pass *analysis.Pass, arg ast.Expr, pkgPath string) (fieldConstructor, bool) {
+	call, ok := ast.Unparen(arg).(*ast.CallExpr)
+	if !ok {
+		return fieldConstructor{}, false
+	}
+
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return fieldConstructor{}, false
+	}
+
+	fn, _ := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if fn == nil || fn.Pkg() == nil || rules.VendorLessPath(fn.Pkg().Path()) != pkgPath {
+		return fieldConstructor{}, false
+	}
+
+	if fn.Type().(*types.Signature).Recv() != nil {
+		return fieldConstructor{}, false
+	}
+	return fieldConstructor{call: call, pkg: renderNode(pass.Fset, sel.X), name: fn.Name()}, true
+}
+
+// replace reports the field constructor call, with a fix calling the constructor funcName of the same package
+// with the args instead.
+func (f fieldConstructor) replace(pass *analysis.Pass, problem, funcName string, args ...ast.Expr) {
+	rendered := make([]string, 0, len(args))
+	for _, arg := range args {
+		rendered = append(rendered, renderNode(pass.Fset, arg))
+	}
+	replacement := fmt.Sprintf("%s.%s(%s)", f.pkg, funcName, strings.Join(rendered, ", "))
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      f.call.Pos(),
+		End:      f.call.End(),
+		Category: DiagnosticCategory,
+		Message:  fmt.Sprintf("%s, use %s.%s instead", problem, f.pkg, funcName),
+		SuggestedFixes: []analysis.SuggestedFix{
+			{
+				Message: fmt.Sprintf("Use %s", replacement),
+				TextEdits: []analysis.TextEdit{
+					{Pos: f.call.Pos(), End: f.call.End(), NewText: []byte(replacement)},
+				},
+			},
+		},
+	})
+}
+
+// stringifiedProblem describes the conversion of the value to a string by the expression.
+func stringifiedProblem(pass *analysis.Pass, expr ast.Expr, isError bool) string {
+	kind := "value"
+	if isError {
+		kind = "error"
+	}
+	return fmt.Sprintf("%s converts the %s to a string", renderNodeEllipsis(pass.Fset, expr), kind)
+}
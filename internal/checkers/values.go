@@ -0,0 +1,171 @@
+package checkers
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/timonwong/loggercheck/internal/rules"
+	"github.com/timonwong/loggercheck/internal/sets"
+)
+
+// logMarshalerMethods are the methods logger libraries call to customize how a value is logged:
+// slog.LogValuer, zapcore.ObjectMarshaler and logr.Marshaler.
+var logMarshalerMethods = []string{"LogValue", "MarshalLogObject", "MarshalLog"}
+
+// serializerMethods are the methods encoders use to serialize values, besides logMarshalerMethods.
+var serializerMethods = []string{"String", "Error", "MarshalJSON"}
+
+// LoggingValues returns the values of key-value pairs and the values of strongly-typed fields constructed inline.
+func LoggingValues(c Checker, pass *analysis.Pass, call CallContext) []ast.Expr {
+	args, keyValues, ok := variadicArgs(call)
+	if !ok {
+		return nil
+	}
+
+	var values []ast.Expr
+	if keyValues {
+		keyAndValues := c.FilterKeyAndValues(pass, args)
+		for i := 1; i < len(keyAndValues); i += 2 {
+			values = append(values, keyAndValues[i])
+		}
+	}
+	for _, arg := range args {
+		if key, ok := c.FieldKey(pass, arg); ok && key.Value != nil {
+			values = append(values, key.Value)
+		}
+	}
+	return values
+}
+
+// hasMethod returns true if the method set of the type contains any of the methods.
+func hasMethod(typ types.Type, names ...string) bool {
+	mset := types.NewMethodSet(typ)
+	for _, name := range names {
+		if mset.Lookup(nil, name) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitiveReason returns why values of the type are sensitive: the type is listed in sensitiveTypes,
+// or it has a field tagged `log:"redact"` or `sensitive:"true"`, including fields of nested structs.
+// Values which redact themselves with a logMarshalerMethods method are not sensitive, encoders do not call
+// these methods on nested fields and elements though.
+func sensitiveReason(typ types.Type, sensitiveTypes sets.StringSet) (string, bool) {
+	if hasMethod(typ, logMarshalerMethods...) {
+		return "", false
+	}
+	return nestedSensitiveReason(typ, sensitiveTypes, "", make(map[types.Type]bool))
+}
+
+// nestedSensitiveReason returns why values of the type are sensitive, path is the path of the nested field
+// of the type, like "Envelope.Token", empty for the logged value itself.
+func nestedSensitiveReason(typ types.Type, sensitiveTypes sets.StringSet, path string, seen map[types.Type]bool) (string, bool) {
+	if seen[typ] {
+		return "", false
+	}
+	seen[typ] = true
+
+	switch t := types.Unalias(typ).(type) {
+	case *types.Pointer:
+		return nestedSensitiveReason(t.Elem(), sensitiveTypes, path, seen)
+	case *types.Slice:
+		return nestedSensitiveReason(t.Elem(), sensitiveTypes, path, seen)
+	case *types.Array:
+		return nestedSensitiveReason(t.Elem(), sensitiveTypes, path, seen)
+	case *types.Map:
+		return nestedSensitiveReason(t.Elem(), sensitiveTypes, path, seen)
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() == nil {
+			return nestedSensitiveReason(t.Underlying(), sensitiveTypes, path, seen)
+		}
+		if listed := rules.VendorLessPath(obj.Pkg().Path()) + "." + obj.Name(); sensitiveTypes.Has(listed) {
+			if path == "" {
+				return fmt.Sprintf("the type %s is listed as sensitive", listed), true
+			}
+			return fmt.Sprintf("field %s has type %s, which is listed as sensitive", path, listed), true
+		}
+		return nestedSensitiveReason(t.Underlying(), sensitiveTypes, path, seen)
+	case *types.Struct:
+		return sensitiveFieldReason(t, sensitiveTypes, path, seen)
+	}
+	return "", false
+}
+
+// sensitiveFieldReason returns why the fields of the struct are sensitive.
+func sensitiveFieldReason(st *types.Struct, sensitiveTypes sets.StringSet, path string, seen map[types.Type]bool) (string, bool) {
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		fieldPath := field.Name()
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+
+		tag := reflect.StructTag(st.Tag(i))
+		if tag.Get("log") == "redact" {
+			return fmt.Sprintf("field %s is tagged `log:\"redact\"`", fieldPath), true
+		}
+		if tag.Get("sensitive") == "true" {
+			return fmt.Sprintf("field %s is tagged `sensitive:\"true\"`", fieldPath), true
+		}
+		if reason, ok := nestedSensitiveReason(field.Type(), sensitiveTypes, fieldPath, seen); ok {
+			return reason, true
+		}
+	}
+	return "", false
+}
+
+// badValueReason returns why values of the type serialize badly with JSON encoders, types implementing
+// fmt.Stringer, error, json.Marshaler or any of the logMarshalerMethods are fine.
+func badValueReason(typ types.Type, qf types.Qualifier) (string, bool) {
+	if hasMethod(typ, logMarshalerMethods...) || hasMethod(typ, serializerMethods...) {
+		return "", false
+	}
+
+	if ptr, ok := types.Unalias(typ).(*types.Pointer); ok {
+		return badValueReason(ptr.Elem(), qf)
+	}
+
+	switch t := typ.Underlying().(type) {
+	case *types.Signature:
+		return "functions cannot be serialized", true
+	case *types.Chan:
+		return "channels cannot be serialized", true
+	case *types.Basic:
+		if t.Kind() == types.UnsafePointer {
+			return "unsafe pointers cannot be serialized", true
+		}
+	case *types.Struct:
+		if t.NumFields() == 0 {
+			return "", false
+		}
+		for i := 0; i < t.NumFields(); i++ {
+			if t.Field(i).Exported() {
+				return "", false
+			}
+		}
+		return "the struct has no exported fields, it is serialized as {}", true
+	case *types.Map:
+		if !isJSONMapKey(t.Key()) {
+			return fmt.Sprintf("map keys of type %s cannot be serialized", types.TypeString(t.Key(), qf)), true
+		}
+	}
+	return "", false
+}
+
+// isJSONMapKey returns true if encoding/json accepts the type as map keys:
+// strings, integers and types implementing encoding.TextMarshaler.
+func isJSONMapKey(typ types.Type) bool {
+	if hasMethod(typ, "MarshalText") {
+		return true
+	}
+
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&(types.IsString|types.IsInteger) != 0
+}
@@ -2,6 +2,8 @@ package checkers
 
 import (
 	"go/ast"
+	"go/types"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 )
@@ -18,4 +20,91 @@ func (z Zap) FilterKeyAndValues(pass *analysis.Pass, keyAndValues []ast.Expr) []
 	return filterKeyAndValues(pass, keyAndValues, "Field")
 }
 
+func (z Zap) FieldKey(pass *analysis.Pass, arg ast.Expr) (Key, bool) {
+	key, ok := fieldKeyOf(pass, arg, "Field")
+	if field, isField := fieldConstructorOf(pass, arg, "go.uber.org/zap"); ok && isField && field.name == "Namespace" {
+		key.Namespace = true
+	}
+	return key, ok
+}
+
+func (z Zap) FieldKeyExpr(pass *analysis.Pass, arg ast.Expr) (ast.Expr, bool) {
+	return fieldKeyExprOf(pass, arg, "Field")
+}
+
+// ReservedKeys returns the keys used by the default encoder config, see zap.NewProductionEncoderConfig.
+func (z Zap) ReservedKeys(_ *types.Func) []string {
+	return []string{"ts", "level", "logger", "caller", "msg", "stacktrace"}
+}
+
+// StructuredVariant returns the "w" method of the SugaredLogger, for example "Infow" for Info, Infof and Infoln.
+func (z Zap) StructuredVariant(fn *types.Func) string {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return ""
+	}
+
+	name := strings.TrimSuffix(strings.TrimSuffix(fn.Name(), "f"), "ln") + "w"
+	if method, _, _ := types.LookupFieldOrMethod(recv.Type(), true, fn.Pkg(), name); method == nil {
+		return ""
+	}
+	return name
+}
+
+// CheckLibraryCall reports empty names passed to Named, which returns the logger unchanged.
+func (z Zap) CheckLibraryCall(pass *analysis.Pass, call CallContext, _ []ast.Expr) {
+	if call.Func.Name() != "Named" || len(call.Expr.Args) != 1 {
+		return
+	}
+	if name, ok := extractValueFromStringArg(pass, call.Expr.Args[0]); !ok || name != "" {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      call.Expr.Args[0].Pos(),
+		End:      call.Expr.Args[0].End(),
+		Category: DiagnosticCategory,
+		Message:  "Named is called with an empty name, the logger is returned unchanged",
+	})
+}
+
+func (z Zap) CheckStringifiedValues(pass *analysis.Pass, keyAndValues []ast.Expr, args []ast.Expr) {
+	z.General.CheckStringifiedValues(pass, keyAndValues, args)
+
+	for _, arg := range args {
+		field, ok := fieldConstructorOf(pass, arg, "go.uber.org/zap")
+		if !ok || len(field.call.Args) != 2 {
+			continue
+		}
+
+		key, value := field.call.Args[0], field.call.Args[1]
+		switch field.name {
+		case "Any":
+			if types.Implements(pass.TypesInfo.TypeOf(value), errorType) {
+				z.replaceErrorField(pass, field, field.pkg+".Any is used with an error", key, value)
+			}
+		case "String":
+			stringified, isError, ok := stringifiedValue(pass, value)
+			switch {
+			case !ok:
+			case isError:
+				z.replaceErrorField(pass, field, stringifiedProblem(pass, value, true), key, stringified)
+			case types.Implements(pass.TypesInfo.TypeOf(stringified), stringerType):
+				field.replace(pass, stringifiedProblem(pass, value, false), "Stringer", key, stringified)
+			default:
+				field.replace(pass, stringifiedProblem(pass, value, false), "Any", key, stringified)
+			}
+		}
+	}
+}
+
+// replaceErrorField replaces the field with zap.Error, or zap.NamedError if the key is not the default one.
+func (z Zap) replaceErrorField(pass *analysis.Pass, field fieldConstructor, problem string, key, err ast.Expr) {
+	if name, ok := extractValueFromStringArg(pass, key); ok && name == "error" {
+		field.replace(pass, problem, "Error", err)
+		return
+	}
+	field.replace(pass, problem, "NamedError", key, err)
+}
+
 var _ Checker = (*Zap)(nil)
@@ -0,0 +1,150 @@
+package keystyle
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrUnknownStyle = errors.New("unknown key style")
+
+// Style describes the naming convention of logging keys.
+type Style struct {
+	name    string
+	pattern *regexp.Regexp
+	join    func(words []string) string // nil for custom patterns, keys cannot be converted
+}
+
+const (
+	SnakeCase      = "snake_case"
+	LowerCamelCase = "lowerCamelCase"
+	KebabCase      = "kebab-case"
+	Dotted         = "dotted"
+)
+
+var builtinStyles = []*Style{
+	{
+		name:    SnakeCase,
+		pattern: regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+		join: func(words []string) string {
+			return strings.Join(words, "_")
+		},
+	},
+	{
+		name:    LowerCamelCase,
+		pattern: regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+		join: func(words []string) string {
+			buf := &strings.Builder{}
+			for i, word := range words {
+				if i > 0 {
+					r, size := utf8.DecodeRuneInString(word)
+					buf.WriteRune(unicode.ToUpper(r))
+					word = word[size:]
+				}
+				buf.WriteString(word)
+			}
+			return buf.String()
+		},
+	},
+	{
+		name:    KebabCase,
+		pattern: regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`),
+		join: func(words []string) string {
+			return strings.Join(words, "-")
+		},
+	},
+	{
+		// Segments may contain underscores, as OpenTelemetry attributes do: "http.status_code".
+		name:    Dotted,
+		pattern: regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z][a-z0-9_]*)*$`),
+		join: func(words []string) string {
+			return strings.Join(words, ".")
+		},
+	},
+}
+
+// Parse returns the built-in style by name: snake_case, lowerCamelCase, kebab-case or dotted.
+func Parse(name string) (*Style, error) {
+	for _, style := range builtinStyles {
+		if style.name == name {
+			return style, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownStyle, name)
+}
+
+// FromPattern returns a style which requires keys to match the regular expression.
+func FromPattern(expr string) (*Style, error) {
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Style{name: expr, pattern: pattern}, nil
+}
+
+func (s *Style) String() string {
+	return s.name
+}
+
+// Match returns true if the key follows the style.
+func (s *Style) Match(key string) bool {
+	return s.pattern.MatchString(key)
+}
+
+// Convert rewrites the key into the style, ok is false if the key cannot be converted.
+func (s *Style) Convert(key string) (converted string, ok bool) {
+	if s.join == nil {
+		return "", false
+	}
+
+	words := SplitWords(key)
+	if len(words) == 0 {
+		return "", false
+	}
+
+	converted = s.join(words)
+	if !s.Match(converted) {
+		return "", false
+	}
+	return converted, true
+}
+
+// SplitWords splits the key into lower-cased words, by separators and case changes.
+// For example: "HTTPRequest_id" is split into "http", "request" and "id".
+func SplitWords(key string) []string {
+	var words []string
+	runes := []rune(key)
+	start := -1
+	flush := func(end int) {
+		if start >= 0 {
+			words = append(words, strings.ToLower(string(runes[start:end])))
+			start = -1
+		}
+	}
+
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush(i)
+			continue
+		}
+
+		if start >= 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// "userID" => "user", "ID"; "HTTPServer" => "HTTP", "Server"
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				flush(i)
+			}
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+	flush(len(runes))
+
+	return words
+}
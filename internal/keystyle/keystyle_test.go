@@ -0,0 +1,116 @@
+package keystyle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitWords(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "empty",
+			input: "",
+			want:  nil,
+		},
+		{
+			name:  "single",
+			input: "user",
+			want:  []string{"user"},
+		},
+		{
+			name:  "camel",
+			input: "userID",
+			want:  []string{"user", "id"},
+		},
+		{
+			name:  "pascal-acronym",
+			input: "HTTPServerName",
+			want:  []string{"http", "server", "name"},
+		},
+		{
+			name:  "mixed-separators",
+			input: "http.request-id_v2",
+			want:  []string{"http", "request", "id", "v2"},
+		},
+		{
+			name:  "digits",
+			input: "ipv4Address",
+			want:  []string{"ipv4", "address"},
+		},
+		{
+			name:  "separators-only",
+			input: "__",
+			want:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SplitWords(tc.input)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestStyle(t *testing.T) {
+	testCases := []struct {
+		style         string
+		key           string
+		wantMatch     bool
+		wantConverted string
+	}{
+		{style: SnakeCase, key: "user_id", wantMatch: true, wantConverted: "user_id"},
+		{style: SnakeCase, key: "userID", wantConverted: "user_id"},
+		{style: SnakeCase, key: "User-Name", wantConverted: "user_name"},
+		{style: LowerCamelCase, key: "userId", wantMatch: true, wantConverted: "userId"},
+		{style: LowerCamelCase, key: "userID", wantMatch: true, wantConverted: "userId"},
+		{style: LowerCamelCase, key: "user_id", wantConverted: "userId"},
+		{style: KebabCase, key: "user-id", wantMatch: true, wantConverted: "user-id"},
+		{style: KebabCase, key: "user_id", wantConverted: "user-id"},
+		{style: Dotted, key: "http.status_code", wantMatch: true, wantConverted: "http.status.code"},
+		{style: Dotted, key: "httpStatus", wantConverted: "http.status"},
+		{style: SnakeCase, key: "键", wantConverted: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.style+"/"+tc.key, func(t *testing.T) {
+			t.Parallel()
+
+			style, err := Parse(tc.style)
+			require.NoError(t, err)
+			assert.Equal(t, tc.style, style.String())
+			assert.Equal(t, tc.wantMatch, style.Match(tc.key))
+
+			converted, ok := style.Convert(tc.key)
+			assert.Equal(t, tc.wantConverted != "", ok)
+			assert.Equal(t, tc.wantConverted, converted)
+		})
+	}
+}
+
+func TestParse_Unknown(t *testing.T) {
+	_, err := Parse("SCREAMING_CASE")
+	assert.ErrorIs(t, err, ErrUnknownStyle)
+}
+
+func TestFromPattern(t *testing.T) {
+	style, err := FromPattern(`^[a-z]+$`)
+	require.NoError(t, err)
+	assert.Equal(t, `^[a-z]+$`, style.String())
+	assert.True(t, style.Match("user"))
+	assert.False(t, style.Match("user_id"))
+
+	_, ok := style.Convert("user_id")
+	assert.False(t, ok)
+
+	_, err = FromPattern(`[`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+// Package msgstyle checks logging messages against the style of the Kubernetes structured logging guidelines.
+package msgstyle
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrUnknownRule = errors.New("unknown message style rule")
+
+const (
+	Capitalized   = "capitalized"   // the message starts with a capital letter
+	NoPunctuation = "nopunctuation" // the message does not end with punctuation
+	NoNewline     = "nonewline"     // the message does not contain newlines
+	NonEmpty      = "nonempty"      // the message is not empty
+)
+
+var knownRules = []string{Capitalized, NoPunctuation, NoNewline, NonEmpty}
+
+// trailingPunctuation are the characters messages should not end with.
+const trailingPunctuation = ".,:;!?"
+
+// Style is a set of message style rules.
+type Style struct {
+	rules  map[string]bool
+	maxLen int // maximal length in characters, 0 for unlimited
+}
+
+// Parse returns the style enforcing the named rules, and the maximal length if maxLen is positive.
+func Parse(rules []string, maxLen int) (*Style, error) {
+	s := &Style{
+		rules:  make(map[string]bool, len(rules)),
+		maxLen: maxLen,
+	}
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if !isKnownRule(rule) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownRule, rule)
+		}
+		s.rules[rule] = true
+	}
+	return s, nil
+}
+
+func isKnownRule(rule string) bool {
+	for _, known := range knownRules {
+		if rule == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Violation is a message breaking a style rule.
+type Violation struct {
+	Message string // describes the violation
+	Fixable bool   // the violation is fixed by Style.Fix
+}
+
+// Check returns the violations of the message.
+func (s *Style) Check(msg string) []Violation {
+	if strings.TrimSpace(msg) == "" {
+		if s.rules[NonEmpty] {
+			return []Violation{{Message: "logging message should not be empty"}}
+		}
+		return nil
+	}
+
+	var violations []Violation
+	if s.rules[Capitalized] && capitalize(msg) != msg {
+		violations = append(violations, Violation{
+			Message: "logging message should start with a capital letter",
+			Fixable: true,
+		})
+	}
+
+	if s.rules[NoPunctuation] && trimPunctuation(msg) != msg {
+		violations = append(violations, Violation{
+			Message: "logging message should not end with punctuation",
+			Fixable: true,
+		})
+	}
+
+	if s.rules[NoNewline] && strings.ContainsAny(msg, "\r\n") {
+		violations = append(violations, Violation{
+			Message: "logging message should not contain newlines",
+			Fixable: true,
+		})
+	}
+
+	if s.maxLen > 0 && utf8.RuneCountInString(msg) > s.maxLen {
+		violations = append(violations, Violation{
+			Message: fmt.Sprintf("logging message should be at most %d characters long", s.maxLen),
+		})
+	}
+
+	return violations
+}
+
+// Fix returns the message with all fixable violations fixed.
+func (s *Style) Fix(msg string) string {
+	if s.rules[NoNewline] {
+		msg = strings.Join(strings.Fields(msg), " ")
+	}
+	if s.rules[NoPunctuation] {
+		msg = trimPunctuation(msg)
+	}
+	if s.rules[Capitalized] {
+		msg = capitalize(msg)
+	}
+	return msg
+}
+
+func capitalize(msg string) string {
+	r, size := utf8.DecodeRuneInString(msg)
+	if !unicode.IsLower(r) {
+		return msg
+	}
+	return string(unicode.ToUpper(r)) + msg[size:]
+}
+
+// trimPunctuation removes the trailing punctuation, ellipses are kept since they are intended.
+func trimPunctuation(msg string) string {
+	trimmed := strings.TrimRightFunc(msg, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(trailingPunctuation, r)
+	})
+	if !strings.ContainsAny(msg[len(trimmed):], trailingPunctuation) || strings.HasSuffix(msg, "...") {
+		return msg
+	}
+	return trimmed
+}
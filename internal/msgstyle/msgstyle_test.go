@@ -0,0 +1,97 @@
+package msgstyle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStyle_Check(t *testing.T) {
+	style, err := Parse([]string{Capitalized, NoPunctuation, NoNewline, NonEmpty}, 20)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		msg   string
+		want  []Violation
+		fixed string
+	}{
+		{
+			name:  "ok",
+			msg:   "Pod started",
+			fixed: "Pod started",
+		},
+		{
+			name:  "ellipsis",
+			msg:   "Waiting...",
+			fixed: "Waiting...",
+		},
+		{
+			name: "empty",
+			msg:  " ",
+			want: []Violation{{Message: "logging message should not be empty"}},
+		},
+		{
+			name:  "lower-case",
+			msg:   "pod started",
+			want:  []Violation{{Message: "logging message should start with a capital letter", Fixable: true}},
+			fixed: "Pod started",
+		},
+		{
+			name:  "punctuation",
+			msg:   "Pod started. ",
+			want:  []Violation{{Message: "logging message should not end with punctuation", Fixable: true}},
+			fixed: "Pod started",
+		},
+		{
+			name:  "trailing-space",
+			msg:   "Pod started ",
+			fixed: "Pod started",
+		},
+		{
+			name:  "newline",
+			msg:   "Pod\n  started",
+			want:  []Violation{{Message: "logging message should not contain newlines", Fixable: true}},
+			fixed: "Pod started",
+		},
+		{
+			name:  "too-long",
+			msg:   "Pod started on the node",
+			want:  []Violation{{Message: "logging message should be at most 20 characters long"}},
+			fixed: "Pod started on the node",
+		},
+		{
+			name: "multiple",
+			msg:  "failed!\n",
+			want: []Violation{
+				{Message: "logging message should start with a capital letter", Fixable: true},
+				{Message: "logging message should not end with punctuation", Fixable: true},
+				{Message: "logging message should not contain newlines", Fixable: true},
+			},
+			fixed: "Failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, style.Check(tc.msg))
+			if tc.fixed != "" {
+				assert.Equal(t, tc.fixed, style.Fix(tc.msg))
+			}
+		})
+	}
+}
+
+func TestStyle_CheckDisabled(t *testing.T) {
+	style, err := Parse(nil, 0)
+	require.NoError(t, err)
+	assert.Empty(t, style.Check(""))
+	assert.Empty(t, style.Check("pod started.\n"))
+}
+
+func TestParse_Unknown(t *testing.T) {
+	_, err := Parse([]string{Capitalized, "lowercase"}, 0)
+	assert.ErrorIs(t, err, ErrUnknownRule)
+	assert.EqualError(t, err, `unknown message style rule: "lowercase"`)
+}
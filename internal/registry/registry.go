@@ -0,0 +1,86 @@
+package registry
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/timonwong/loggercheck/internal/stringutil"
+)
+
+var (
+	ErrInvalidEntry = errors.New("invalid registry entry")
+	ErrDuplicateKey = errors.New("duplicate registry key")
+)
+
+// Entry is a registered logging key, with an optional type of its value.
+type Entry struct {
+	Key  string
+	Type string // type of the value, for example: "int64" or "time.Duration", empty if any type is allowed
+}
+
+// Registry is the allowlist of logging keys.
+type Registry struct {
+	entries map[string]Entry
+	keys    []string // sorted, for stable suggestions
+}
+
+// Parse reads the registry, one key per line, optionally followed by the type of its value:
+//
+//	# comment
+//	request_id
+//	user_id: int64
+//	duration: time.Duration
+func Parse(r io.Reader) (*Registry, error) {
+	reg := &Registry{entries: make(map[string]Entry)}
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, typ, _ := strings.Cut(line, ":")
+		entry := Entry{
+			Key:  strings.TrimSpace(key),
+			Type: strings.TrimSpace(typ),
+		}
+		if entry.Key == "" || strings.ContainsAny(entry.Type, " \t") {
+			return nil, fmt.Errorf("error parse registry at line %d: %w", lineNo, ErrInvalidEntry)
+		}
+		if _, ok := reg.entries[entry.Key]; ok {
+			return nil, fmt.Errorf("error parse registry at line %d: %w: %q", lineNo, ErrDuplicateKey, entry.Key)
+		}
+
+		reg.entries[entry.Key] = entry
+		reg.keys = append(reg.keys, entry.Key)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(reg.keys)
+	return reg, nil
+}
+
+// Lookup returns the registered entry of the key.
+func (reg *Registry) Lookup(key string) (Entry, bool) {
+	entry, ok := reg.entries[key]
+	return entry, ok
+}
+
+// Nearest returns the registered key nearest to key by edit distance, ok is false if no key is close enough.
+func (reg *Registry) Nearest(key string) (nearest string, ok bool) {
+	// Allow editing up to a half of the key, otherwise the suggestion is likely unrelated.
+	best := len([]rune(key))/2 + 1
+	for _, candidate := range reg.keys {
+		if d := stringutil.EditDistance(key, candidate); d < best {
+			nearest, best, ok = candidate, d, true
+		}
+	}
+	return nearest, ok
+}
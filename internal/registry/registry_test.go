@@ -0,0 +1,103 @@
+package registry
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	reg, err := Parse(strings.NewReader(`
+# Logging keys
+request_id
+user_id: int64
+  duration :  time.Duration
+`))
+	require.NoError(t, err)
+
+	entry, ok := reg.Lookup("request_id")
+	assert.True(t, ok)
+	assert.Equal(t, Entry{Key: "request_id"}, entry)
+
+	entry, ok = reg.Lookup("user_id")
+	assert.True(t, ok)
+	assert.Equal(t, Entry{Key: "user_id", Type: "int64"}, entry)
+
+	entry, ok = reg.Lookup("duration")
+	assert.True(t, ok)
+	assert.Equal(t, Entry{Key: "duration", Type: "time.Duration"}, entry)
+
+	_, ok = reg.Lookup("# Logging keys")
+	assert.False(t, ok)
+}
+
+func TestParse_Error(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		wantError error
+	}{
+		{
+			name:      "empty-key",
+			input:     "user_id\n: int64",
+			wantError: ErrInvalidEntry,
+		},
+		{
+			name:      "invalid-type",
+			input:     "request_id\nuser_id: int 64",
+			wantError: ErrInvalidEntry,
+		},
+		{
+			name:      "duplicate-key",
+			input:     "user_id\nuser_id: int64",
+			wantError: ErrDuplicateKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(strings.NewReader(tc.input))
+			assert.ErrorIs(t, err, tc.wantError)
+			assert.ErrorContains(t, err, "at line 2")
+		})
+	}
+}
+
+func TestParse_IOError(t *testing.T) {
+	r := iotest.ErrReader(errors.New("broken IO"))
+	_, err := Parse(r)
+	assert.EqualError(t, err, "broken IO")
+}
+
+func TestNearest(t *testing.T) {
+	reg, err := Parse(strings.NewReader("user_id\nuser_name\nrequest_id\nid"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		key       string
+		want      string
+		wantFound bool
+	}{
+		{key: "usr_id", want: "user_id", wantFound: true},
+		{key: "user_nam", want: "user_name", wantFound: true},
+		{key: "requestid", want: "request_id", wantFound: true},
+		{key: "ip", want: "id", wantFound: true},
+		{key: "latency"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.key, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := reg.Nearest(tc.key)
+			assert.Equal(t, tc.wantFound, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -23,24 +23,25 @@ type Ruleset struct {
 	ruleIndicesByFuncName map[string][]int
 }
 
-func (rs *Ruleset) Match(fn *types.Func) bool {
+// Match returns the rule matching the function.
+func (rs *Ruleset) Match(fn *types.Func) (*FuncRule, bool) {
 	// PackageImport is already checked (by indices), skip checking it here
 	sig := fn.Type().(*types.Signature) // it's safe since we already checked
 
 	// Fail fast if the function name is not in the rule list.
 	indices, ok := rs.ruleIndicesByFuncName[fn.Name()]
 	if !ok {
-		return false
+		return nil, false
 	}
 
 	for _, idx := range indices {
 		rule := &rs.Rules[idx]
 		if matchRule(rule, sig) {
-			return true
+			return rule, true
 		}
 	}
 
-	return false
+	return nil, false
 }
 
 var receiverTypeCache = typeutil.Map{}
@@ -109,13 +110,54 @@ func matchRule(p *FuncRule, sig *types.Signature) bool {
 	return true
 }
 
+// VendorLessPath returns the devendorized version of the import path ipath.
+// For example: "a/vendor/github.com/go-logr/logr" will become "github.com/go-logr/logr".
+func VendorLessPath(ipath string) string {
+	if i := strings.LastIndex(ipath, "/vendor/"); i >= 0 {
+		return ipath[i+len("/vendor/"):]
+	}
+	return ipath
+}
+
+// FuncKind is the kind of arguments taken by a logging function.
+type FuncKind int
+
+const (
+	Structured FuncKind = iota // a message followed by key-value pairs, the default
+	Print                      // arguments formatted like fmt.Sprint, annotated with "(print)"
+	Println                    // arguments formatted like fmt.Sprintln, annotated with "(println)"
+	Printf                     // a format and its arguments, annotated with "(printf:format)"
+)
+
 type FuncRule struct { // package import should be accessed from Rulset
 	ReceiverType string
 	FuncName     string
 	IsReceiver   bool
+	Kind         FuncKind
+	HasMessage   bool // the rule declares the message parameter
+	MessagePos   int  // index of the message parameter, or of the format parameter of Printf functions
+}
+
+// MessageIndex returns the index of the message parameter, or -1 if the rule does not declare it.
+func (p *FuncRule) MessageIndex() int {
+	if !p.HasMessage {
+		return -1
+	}
+	return p.MessagePos
 }
 
+// ParseFuncRule parses rules like "(*go.uber.org/zap.SugaredLogger).Infow(msg)", the optional annotation in
+// parentheses declares the position of the message parameter: "msg" for the first parameter, "_, msg" for the second
+// one and so on. Functions which do not take key-value pairs are annotated with their kind instead:
+// "(print)", "(println)" or "(printf:format)" with the position of the format parameter, like "(printf:_, format)".
 func ParseFuncRule(rule string) (packageImport string, pat FuncRule, err error) {
+	if open := strings.LastIndex(rule, "("); open > 0 && strings.HasSuffix(rule, ")") {
+		if !parseAnnotation(rule[open+1:len(rule)-1], &pat) {
+			return "", FuncRule{}, ErrInvalidRule
+		}
+		rule = rule[:open]
+	}
+
 	lastDot := strings.LastIndexFunc(rule, func(r rune) bool {
 		return r == '.' || r == '/'
 	})
@@ -158,6 +200,36 @@ func ParseFuncRule(rule string) (packageImport string, pat FuncRule, err error)
 	return packageImport, pat, nil
 }
 
+// parseAnnotation parses the kind and the position of the message parameter of a rule, the parameters before
+// the message are written "_".
+func parseAnnotation(annotation string, pat *FuncRule) bool {
+	marker := "msg"
+	switch {
+	case annotation == "print":
+		pat.Kind = Print
+		return true
+	case annotation == "println":
+		pat.Kind = Println
+		return true
+	case strings.HasPrefix(annotation, "printf:"):
+		pat.Kind = Printf
+		annotation = strings.TrimPrefix(annotation, "printf:")
+		marker = "format"
+	}
+
+	params := strings.Split(annotation, ",")
+	for i, param := range params {
+		param = strings.TrimSpace(param)
+		if (i < len(params)-1 && param != "_") || (i == len(params)-1 && param != marker) {
+			return false
+		}
+	}
+
+	pat.HasMessage = true
+	pat.MessagePos = len(params) - 1
+	return true
+}
+
 func ParseRules(lines []string) (result []Ruleset, err error) {
 	rulesByImport := make(map[string][]FuncRule)
 	for i, line := range lines {
@@ -67,6 +67,102 @@ func TestParseFuncRule(t *testing.T) {
 				FuncName:     "Error",
 			},
 		},
+		{
+			name:              "logr-message",
+			rule:              "(github.com/go-logr/logr.Logger).Error(_, msg)",
+			wantPackageImport: "github.com/go-logr/logr",
+			wantRule: FuncRule{
+				IsReceiver:   true,
+				ReceiverType: "Logger",
+				FuncName:     "Error",
+				HasMessage:   true,
+				MessagePos:   1,
+			},
+		},
+		{
+			name:              "klog-message-no-receiver",
+			rule:              "k8s.io/klog/v2.InfoS(msg)",
+			wantPackageImport: "k8s.io/klog/v2",
+			wantRule: FuncRule{
+				FuncName:   "InfoS",
+				HasMessage: true,
+			},
+		},
+		{
+			name:              "zap-print",
+			rule:              "(*go.uber.org/zap.SugaredLogger).Info(print)",
+			wantPackageImport: "go.uber.org/zap",
+			wantRule: FuncRule{
+				IsReceiver:   true,
+				ReceiverType: "*SugaredLogger",
+				FuncName:     "Info",
+				Kind:         Print,
+			},
+		},
+		{
+			name:              "zap-println",
+			rule:              "(*go.uber.org/zap.SugaredLogger).Infoln(println)",
+			wantPackageImport: "go.uber.org/zap",
+			wantRule: FuncRule{
+				IsReceiver:   true,
+				ReceiverType: "*SugaredLogger",
+				FuncName:     "Infoln",
+				Kind:         Println,
+			},
+		},
+		{
+			name:              "zap-printf",
+			rule:              "(*go.uber.org/zap.SugaredLogger).Infof(printf:format)",
+			wantPackageImport: "go.uber.org/zap",
+			wantRule: FuncRule{
+				IsReceiver:   true,
+				ReceiverType: "*SugaredLogger",
+				FuncName:     "Infof",
+				Kind:         Printf,
+				HasMessage:   true,
+			},
+		},
+		{
+			name:              "klog-printf-depth",
+			rule:              "k8s.io/klog/v2.InfofDepth(printf:_, format)",
+			wantPackageImport: "k8s.io/klog/v2",
+			wantRule: FuncRule{
+				FuncName:   "InfofDepth",
+				Kind:       Printf,
+				HasMessage: true,
+				MessagePos: 1,
+			},
+		},
+		{
+			name:      "invalid-rule-printf-no-format",
+			rule:      "k8s.io/klog/v2.Infof(printf:)",
+			wantError: ErrInvalidRule,
+		},
+		{
+			name:      "invalid-rule-empty-message",
+			rule:      "k8s.io/klog/v2.InfoS()",
+			wantError: ErrInvalidRule,
+		},
+		{
+			name:      "invalid-rule-bad-message",
+			rule:      "k8s.io/klog/v2.InfoS(msg, args)",
+			wantError: ErrInvalidRule,
+		},
+		{
+			name:      "invalid-rule-named-message",
+			rule:      "k8s.io/klog/v2.InfoS(text)",
+			wantError: ErrInvalidRule,
+		},
+		{
+			name:      "invalid-rule-named-param",
+			rule:      "k8s.io/klog/v2.ErrorS(err, msg)",
+			wantError: ErrInvalidRule,
+		},
+		{
+			name:      "invalid-rule-printf-message",
+			rule:      "k8s.io/klog/v2.Infof(printf:msg)",
+			wantError: ErrInvalidRule,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -85,6 +181,14 @@ func TestParseFuncRule(t *testing.T) {
 	}
 }
 
+func TestFuncRule_MessageIndex(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, (&FuncRule{HasMessage: true}).MessageIndex())
+	assert.Equal(t, 1, (&FuncRule{HasMessage: true, MessagePos: 1}).MessageIndex())
+	assert.Equal(t, -1, (&FuncRule{}).MessageIndex())
+}
+
 func TestReceiverTypeOf_InvalidType(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,69 @@
+// Package secrets detects logging keys and constant strings which look like credentials.
+package secrets
+
+import (
+	"regexp"
+
+	"github.com/timonwong/loggercheck/internal/keystyle"
+	"github.com/timonwong/loggercheck/internal/stringutil"
+)
+
+// DefaultSensitiveKeys is the list of sensitive logging keys used when none is configured.
+var DefaultSensitiveKeys = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"api_key",
+	"private_key",
+	"credential",
+	"credentials",
+	"authorization",
+	"cookie",
+	"ssn",
+}
+
+// MatchKey returns the sensitive key the logging key ends with, comparing whole words only: the last words
+// name what is logged. For example: "db_password" and "accessToken" match "password" and "token",
+// but "token_count" does not match "token" and "classname" does not match "ssn".
+func MatchKey(key string, sensitiveKeys []string) (matched string, ok bool) {
+	words := keystyle.SplitWords(key)
+	for _, sensitive := range sensitiveKeys {
+		want := stringutil.NormalizeKey(sensitive)
+		if want == "" {
+			continue
+		}
+
+		joined := ""
+		for i := len(words) - 1; i >= 0 && len(joined) < len(want); i-- {
+			joined = words[i] + joined
+		}
+		if joined == want {
+			return sensitive, true
+		}
+	}
+	return "", false
+}
+
+type pattern struct {
+	kind string
+	re   *regexp.Regexp
+}
+
+var patterns = []pattern{
+	{kind: "an AWS access key", re: regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{kind: "a JSON Web Token", re: regexp.MustCompile(`\beyJ[0-9A-Za-z_-]+\.eyJ[0-9A-Za-z_-]+\.[0-9A-Za-z_-]+`)},
+	{kind: "a private key", re: regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+	{kind: "a GitHub token", re: regexp.MustCompile(`\b(gh[pousr]_[0-9A-Za-z]{36}|github_pat_[0-9A-Za-z_]{22,})\b`)},
+	{kind: "a Slack token", re: regexp.MustCompile(`\bxox[abprs]-[0-9A-Za-z-]{10,}`)},
+}
+
+// Find returns the kind of credential the string contains, for example: "an AWS access key".
+func Find(s string) (kind string, ok bool) {
+	for _, p := range patterns {
+		if p.re.MatchString(s) {
+			return p.kind, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,70 @@
+package stringutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{
+			name: "empty",
+			want: 0,
+		},
+		{
+			name: "empty-a",
+			b:    "abc",
+			want: 3,
+		},
+		{
+			name: "empty-b",
+			a:    "abc",
+			want: 3,
+		},
+		{
+			name: "equal",
+			a:    "user_id",
+			b:    "user_id",
+			want: 0,
+		},
+		{
+			name: "substitution",
+			a:    "user_id",
+			b:    "user-id",
+			want: 1,
+		},
+		{
+			name: "insertion-deletion",
+			a:    "usr_id",
+			b:    "user_ids",
+			want: 2,
+		},
+		{
+			name: "kitten",
+			a:    "kitten",
+			b:    "sitting",
+			want: 3,
+		},
+		{
+			name: "runes",
+			a:    "键1",
+			b:    "键2",
+			want: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := EditDistance(tc.a, tc.b)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
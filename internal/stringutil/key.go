@@ -0,0 +1,22 @@
+package stringutil
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeKey folds a logging key into a canonical form, keys which only differ in case or
+// separator ('_', '-', '.' and spaces) share the same canonical form.
+// For example: "userID", "user_id", "User-ID" and "user.id" are all normalized to "userid".
+func NormalizeKey(s string) string {
+	buf := &strings.Builder{}
+	buf.Grow(len(s))
+	for _, r := range s {
+		switch r {
+		case '_', '-', '.', ' ':
+			continue
+		}
+		buf.WriteRune(unicode.ToLower(r))
+	}
+	return buf.String()
+}
@@ -0,0 +1,60 @@
+package stringutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeKey(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "empty",
+			input: "",
+			want:  "",
+		},
+		{
+			name:  "lower",
+			input: "userid",
+			want:  "userid",
+		},
+		{
+			name:  "camel",
+			input: "userID",
+			want:  "userid",
+		},
+		{
+			name:  "snake",
+			input: "user_id",
+			want:  "userid",
+		},
+		{
+			name:  "kebab",
+			input: "User-ID",
+			want:  "userid",
+		},
+		{
+			name:  "dotted",
+			input: "user.id",
+			want:  "userid",
+		},
+		{
+			name:  "non-ascii",
+			input: "Ключ_1",
+			want:  "ключ1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NormalizeKey(tc.input)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
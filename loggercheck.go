@@ -1,11 +1,13 @@
 package loggercheck
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"go/ast"
 	"go/types"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 
@@ -15,51 +17,133 @@ import (
 	"golang.org/x/tools/go/types/typeutil"
 
 	"github.com/timonwong/loggercheck/internal/checkers"
+	"github.com/timonwong/loggercheck/internal/keystyle"
+	"github.com/timonwong/loggercheck/internal/msgstyle"
+	"github.com/timonwong/loggercheck/internal/registry"
 	"github.com/timonwong/loggercheck/internal/rules"
+	"github.com/timonwong/loggercheck/internal/secrets"
 	"github.com/timonwong/loggercheck/internal/sets"
 )
 
-const Doc = `Checks key value pairs for common logger libraries (kitlog,klog,logr,slog,zap).`
+const Doc = `Checks key value pairs for common logger libraries (kitlog,klog,logr,logrus,slog,zap).`
 
 func NewAnalyzer(opts ...Option) *analysis.Analyzer {
 	l := newLoggerCheck(opts...)
-	a := &analysis.Analyzer{
+	l.facts = newFactsAnalyzer(l)
+	return &analysis.Analyzer{
 		Name:     "loggercheck",
 		Doc:      Doc,
 		Flags:    *l.fs,
 		Run:      l.run,
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Requires: []*analysis.Analyzer{inspect.Analyzer, l.facts},
 	}
-	return a
 }
 
 type loggercheck struct {
 	fs *flag.FlagSet
 
-	disable          sets.StringSet // flag -disable
-	ruleFile         string         // flag -rulefile
-	requireStringKey bool           // flag -requirestringkey
-	noPrintfLike     bool           // flag -noprintflike
-
-	rules       []string        // used for external integration, for example golangci-lint
-	rulesetList []rules.Ruleset // populate at runtime
+	disable            sets.StringSet // flag -disable
+	ruleFile           string         // flag -rulefile
+	requireStringKey   bool           // flag -requirestringkey
+	noPrintfLike       bool           // flag -noprintflike
+	printfArgs         bool           // flag -printfargs
+	noSwappedKV        bool           // flag -noswappedkv
+	noDupKeys          bool           // flag -nodupkeys
+	foldKeys           bool           // flag -foldkeys
+	noReservedKeys     bool           // flag -noreservedkeys
+	reservedKeys       keysByChecker  // flag -reservedkeys
+	keyStyleName       string         // flag -keystyle
+	keyPattern         string         // flag -keypattern
+	keyRegistryFile    string         // flag -keyregistry
+	keyPackages        sets.StringSet // flag -keypackages
+	noSimilarKeys      bool           // flag -nosimilarkeys
+	similarKeyDistance int            // flag -similarkeydistance
+	noSensitive        bool           // flag -nosensitive
+	sensitiveKeys      sets.StringSet // flag -sensitivekeys
+	sensitiveTypes     sets.StringSet // flag -sensitivetypes
+	noBadValues        bool           // flag -nobadvalues
+	noStringValues     bool           // flag -nostringvalues
+	requireConstMsg    bool           // flag -requireconstmsg
+	msgStyleRules      sets.StringSet // flag -msgstyle
+	msgMaxLen          int            // flag -msgmaxlen
+	noKVInMsg          bool           // flag -nokvinmsg
+	requireKObj        bool           // flag -requirekobj
+	libraryChecks      bool           // flag -librarychecks
+	maxVLevel          int            // flag -maxvlevel
+
+	rules       []string           // used for external integration, for example golangci-lint
+	rulesetList []rules.Ruleset    // populate at runtime
+	keyStyle    *keystyle.Style    // populate at runtime
+	keyRegistry *registry.Registry // populate at runtime
+	msgStyle    *msgstyle.Style    // populate at runtime
 
 	rulesetIndicesByImportMu sync.Mutex
 	rulesetIndicesByImport   map[string][]int // ruleset index, populate at runtime
+
+	configOnce sync.Once
+	configErr  error
+
+	facts *analysis.Analyzer // facts about dependencies, see newFactsAnalyzer
 }
 
 func newLoggerCheck(opts ...Option) *loggercheck {
 	fs := flag.NewFlagSet("loggercheck", flag.ExitOnError)
 	l := &loggercheck{
-		fs:          fs,
-		disable:     sets.NewString("kitlog"),
-		rulesetList: append([]rules.Ruleset{}, staticRuleList...), // ensure we make a clone of static rules first
+		fs:                 fs,
+		disable:            sets.NewString("kitlog", "logrus"),
+		similarKeyDistance: 1,
+		sensitiveKeys:      sets.NewString(secrets.DefaultSensitiveKeys...),
+		rulesetList:        append([]rules.Ruleset{}, staticRuleList...), // ensure we make a clone of static rules first
 	}
 
 	fs.StringVar(&l.ruleFile, "rulefile", "", "path to a file contains a list of rules")
-	fs.Var(&l.disable, "disable", "comma-separated list of disabled logger checker (kitlog,klog,logr,slog,zap)")
+	fs.Var(&l.disable, "disable", "comma-separated list of disabled logger checker (kitlog,klog,logr,logrus,slog,zap)")
 	fs.BoolVar(&l.requireStringKey, "requirestringkey", false, "require all logging keys to be inlined constant strings")
 	fs.BoolVar(&l.noPrintfLike, "noprintflike", false, "require printf-like format specifier not present in args")
+	fs.BoolVar(&l.printfArgs, "printfargs", false,
+		"check the arguments of printf-like logging functions against their format, like the printf check of go vet")
+	fs.BoolVar(&l.noSwappedKV, "noswappedkv", false, "report key-value pairs which look swapped or shifted")
+	fs.BoolVar(&l.noDupKeys, "nodupkeys", false,
+		"report logging keys which are used more than once, including keys already bound to the logger")
+	fs.BoolVar(&l.foldKeys, "foldkeys", false,
+		"treat logging keys which only differ in case or separator as duplicates, works with -nodupkeys")
+	fs.BoolVar(&l.noReservedKeys, "noreservedkeys", false,
+		"report logging keys which collide with keys added by the logger library, for example \"msg\" for slog")
+	fs.Var(&l.reservedKeys, "reservedkeys",
+		"override reserved keys of logger checkers, for example \"zap=ts,level,msg;slog=time,level,msg\"")
+	fs.StringVar(&l.keyStyleName, "keystyle", "",
+		"require logging keys to follow the naming convention (snake_case,lowerCamelCase,kebab-case,dotted)")
+	fs.StringVar(&l.keyPattern, "keypattern", "", "require logging keys to match the regular expression")
+	fs.Var(&l.keyPackages, "keypackages",
+		"comma-separated list of packages, logging keys declared in these packages are allowed by -requirestringkey")
+	fs.StringVar(&l.keyRegistryFile, "keyregistry", "",
+		"path to a file contains the allowed logging keys, optionally with the type of values (key: type)")
+	fs.BoolVar(&l.noSimilarKeys, "nosimilarkeys", false,
+		"report logging keys which are similar to a more common key in the package or its dependencies")
+	fs.IntVar(&l.similarKeyDistance, "similarkeydistance", l.similarKeyDistance,
+		"maximal edit distance of similar logging keys, works with -nosimilarkeys")
+	fs.BoolVar(&l.noSensitive, "nosensitive", false,
+		"report sensitive logging keys, values of sensitive types and constant strings which look like credentials")
+	fs.Var(&l.sensitiveKeys, "sensitivekeys", "comma-separated list of sensitive logging keys, works with -nosensitive")
+	fs.Var(&l.sensitiveTypes, "sensitivetypes",
+		"comma-separated list of sensitive types, for example \"example.com/pkg.Token\", works with -nosensitive")
+	fs.BoolVar(&l.noBadValues, "nobadvalues", false,
+		"report logging values which serialize badly, like functions, channels and maps with unsupported keys")
+	fs.BoolVar(&l.noStringValues, "nostringvalues", false,
+		"report logging values converted to strings, like err.Error(), v.String() and fmt.Sprintf(\"%v\", v)")
+	fs.BoolVar(&l.requireConstMsg, "requireconstmsg", false,
+		"require logging messages to be constant strings, variable parts should be passed as key-value pairs")
+	fs.Var(&l.msgStyleRules, "msgstyle",
+		"comma-separated list of logging message style rules (capitalized,nonempty,nonewline,nopunctuation)")
+	fs.IntVar(&l.msgMaxLen, "msgmaxlen", 0, "maximal length of logging messages, 0 for unlimited")
+	fs.BoolVar(&l.noKVInMsg, "nokvinmsg", false,
+		"report key-value pairs written in logging messages, like \"user=%s\", instead of passed as key-value pairs")
+	fs.BoolVar(&l.requireKObj, "requirekobj", false,
+		"require Kubernetes objects logged by klog and logr to be wrapped in klog.KObj, klog.KRef or klog.KObjSlice")
+	fs.BoolVar(&l.libraryChecks, "librarychecks", false,
+		"report misuses specific to the logger library, like klog errors logged with a key other than \"err\"")
+	fs.IntVar(&l.maxVLevel, "maxvlevel", 0,
+		"maximal V level of logr loggers, non-constant levels are reported as well, 0 for unlimited")
 
 	for _, opt := range opts {
 		opt(l)
@@ -72,22 +156,15 @@ func (l *loggercheck) isCheckerDisabled(name string) bool {
 	return l.disable.Has(name)
 }
 
-// vendorLessPath returns the devendorized version of the import path ipath.
-// For example: "a/vendor/github.com/go-logr/logr" will become "github.com/go-logr/logr".
-func vendorLessPath(ipath string) string {
-	if i := strings.LastIndex(ipath, "/vendor/"); i >= 0 {
-		return ipath[i+len("/vendor/"):]
-	}
-	return ipath
-}
-
-func (l *loggercheck) getCheckerForFunc(fn *types.Func) checkers.Checker {
+func (l *loggercheck) getCheckerForFunc(
+	fn *types.Func,
+) (checker checkers.Checker, rulesetName string, rule *rules.FuncRule) {
 	pkg := fn.Pkg()
 	if pkg == nil {
-		return nil
+		return nil, "", nil
 	}
 
-	pkgPath := vendorLessPath(pkg.Path())
+	pkgPath := rules.VendorLessPath(pkg.Path())
 
 	l.rulesetIndicesByImportMu.Lock()
 	indices := l.rulesetIndicesByImport[pkgPath]
@@ -100,52 +177,107 @@ func (l *loggercheck) getCheckerForFunc(fn *types.Func) checkers.Checker {
 			continue
 		}
 
-		if !rs.Match(fn) {
+		rule, ok := rs.Match(fn)
+		if !ok {
 			continue
 		}
 
 		checker := checkerByRulesetName[rs.Name]
 		if checker == nil {
-			return checkers.General{}
+			return checkers.General{}, rs.Name, rule
 		}
-		return checker
+		return checker, rs.Name, rule
 	}
 
-	return nil
+	return nil, "", nil
 }
 
-func (l *loggercheck) checkLoggerArguments(pass *analysis.Pass, call *ast.CallExpr) {
+// resolveCall returns the checker for the call, ok is false if the call is not a matched logging call.
+func (l *loggercheck) resolveCall(
+	pass *analysis.Pass, call *ast.CallExpr,
+) (checker checkers.Checker, callCtx checkers.CallContext, ok bool) {
 	fn, _ := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
 	if fn == nil {
-		return // function pointer is not supported
+		return nil, callCtx, false // function pointer is not supported
 	}
 
 	sig, ok := fn.Type().(*types.Signature)
-	if !ok || !sig.Variadic() {
-		return // not variadic
+	if !ok {
+		return nil, callCtx, false
 	}
 
 	// ellipsis args is hard, just skip
 	if call.Ellipsis.IsValid() {
-		return
+		return nil, callCtx, false
 	}
 
-	checker := l.getCheckerForFunc(fn)
+	checker, rulesetName, rule := l.getCheckerForFunc(fn)
 	if checker == nil {
+		return nil, callCtx, false
+	}
+
+	return checker, checkers.CallContext{
+		Expr:         call,
+		Func:         fn,
+		Signature:    sig,
+		Ruleset:      rulesetName,
+		Kind:         rule.Kind,
+		MessageIndex: rule.MessageIndex(),
+	}, true
+}
+
+func (l *loggercheck) checkLoggerArguments(pass *analysis.Pass, call *ast.CallExpr) {
+	checker, callCtx, ok := l.resolveCall(pass, call)
+	if !ok {
+		return
+	}
+
+	if params := callCtx.Signature.Params(); callCtx.MessageIndex >= params.Len() {
+		pass.Report(analysis.Diagnostic{
+			Pos:      call.Fun.Pos(),
+			End:      call.Fun.End(),
+			Category: checkers.DiagnosticCategory,
+			Message: fmt.Sprintf("the rule of %s declares the message as parameter %d, the function has %d parameters",
+				callCtx.Func.FullName(), callCtx.MessageIndex+1, params.Len()),
+		})
 		return
 	}
 
-	checkers.ExecuteChecker(checker, pass, checkers.CallContext{
-		Expr:      call,
-		Func:      fn,
-		Signature: sig,
-	}, checkers.Config{
+	checkers.ExecuteChecker(checker, pass, callCtx, checkers.Config{
 		RequireStringKey: l.requireStringKey,
 		NoPrintfLike:     l.noPrintfLike,
+		PrintfArgs:       l.printfArgs,
+		NoSwappedKV:      l.noSwappedKV,
+		NoDupKeys:        l.noDupKeys,
+		FoldKeys:         l.foldKeys,
+		NoReservedKeys:   l.noReservedKeys,
+		ReservedKeys:     l.reservedKeys[callCtx.Ruleset],
+		KeyStyle:         l.keyStyle,
+		KeyRegistry:      l.keyRegistry,
+		KeyPackages:      l.keyPackages,
+		NoSensitive:      l.noSensitive,
+		SensitiveKeys:    l.sensitiveKeys.List(),
+		SensitiveTypes:   l.sensitiveTypes,
+		NoBadValues:      l.noBadValues,
+		NoStringValues:   l.noStringValues,
+		RequireConstMsg:  l.requireConstMsg,
+		MessageStyle:     l.msgStyle,
+		NoKVInMsg:        l.noKVInMsg,
+		RequireKObj:      l.requireKObj,
+		LibraryChecks:    l.libraryChecks,
+		MaxVLevel:        l.maxVLevel,
 	})
 }
 
+// processConfig loads the rules only once, since passes of different packages may run concurrently.
 func (l *loggercheck) processConfig() error {
+	l.configOnce.Do(func() {
+		l.configErr = l.loadConfig()
+	})
+	return l.configErr
+}
+
+func (l *loggercheck) loadConfig() error {
 	if l.ruleFile != "" { // flags takes precedence over configs
 		f, err := os.Open(l.ruleFile)
 		if err != nil {
@@ -166,6 +298,22 @@ func (l *loggercheck) processConfig() error {
 		l.rulesetList = append(l.rulesetList, custom...)
 	}
 
+	if err := l.loadKeyStyle(); err != nil {
+		return err
+	}
+
+	if err := l.loadKeyRegistry(); err != nil {
+		return err
+	}
+
+	if len(l.msgStyleRules) > 0 || l.msgMaxLen > 0 {
+		style, err := msgstyle.Parse(l.msgStyleRules.List(), l.msgMaxLen)
+		if err != nil {
+			return fmt.Errorf("failed to parse message style: %w", err)
+		}
+		l.msgStyle = style
+	}
+
 	// Build index
 	indices := make(map[string][]int)
 	for i, rs := range l.rulesetList {
@@ -179,6 +327,40 @@ func (l *loggercheck) processConfig() error {
 	return nil
 }
 
+func (l *loggercheck) loadKeyStyle() (err error) {
+	switch {
+	case l.keyStyleName != "" && l.keyPattern != "":
+		return errors.New("key style and key pattern cannot be used together")
+	case l.keyStyleName != "":
+		l.keyStyle, err = keystyle.Parse(l.keyStyleName)
+	case l.keyPattern != "":
+		l.keyStyle, err = keystyle.FromPattern(l.keyPattern)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to parse key style: %w", err)
+	}
+	return nil
+}
+
+func (l *loggercheck) loadKeyRegistry() error {
+	if l.keyRegistryFile == "" {
+		return nil
+	}
+
+	f, err := os.Open(l.keyRegistryFile)
+	if err != nil {
+		return fmt.Errorf("failed to open key registry: %w", err)
+	}
+	defer f.Close()
+
+	l.keyRegistry, err = registry.Parse(f)
+	if err != nil {
+		return fmt.Errorf("failed to parse key registry: %w", err)
+	}
+	return nil
+}
+
 func (l *loggercheck) run(pass *analysis.Pass) (interface{}, error) {
 	err := l.processConfig()
 	if err != nil {
@@ -201,5 +383,57 @@ func (l *loggercheck) run(pass *analysis.Pass) (interface{}, error) {
 		l.checkLoggerArguments(pass, call)
 	})
 
+	if l.noDupKeys {
+		l.checkBoundKeys(pass)
+	}
+
+	if l.noSimilarKeys {
+		l.checkSimilarKeys(pass)
+	}
+
 	return nil, nil
 }
+
+// keysByChecker implements flag.Value interface, it maps logger checker names to lists of keys.
+// For example: "zap=ts,level,msg;slog=time,level,msg".
+type keysByChecker map[string][]string
+
+func (m *keysByChecker) Set(v string) error {
+	result := make(keysByChecker)
+	for _, part := range strings.Split(v, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, keys, ok := strings.Cut(part, "=")
+		if !ok {
+			return fmt.Errorf("invalid keys %q, expected format: name=key1,key2", part)
+		}
+
+		list := []string{}
+		for _, key := range strings.Split(keys, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				list = append(list, key)
+			}
+		}
+		result[strings.TrimSpace(name)] = list
+	}
+
+	*m = result
+	return nil
+}
+
+func (m keysByChecker) String() string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, name+"="+strings.Join(m[name], ","))
+	}
+	return strings.Join(parts, ";")
+}
@@ -31,6 +31,56 @@ func TestLinter(t *testing.T) {
 			patterns: "a/all",
 			flags:    []string{"-disable="},
 		},
+		{
+			name:     "container-args",
+			patterns: "a/containerargs",
+		},
+		{
+			name:     "zap-sugar",
+			patterns: "a/zapsugar",
+		},
+		{
+			name:     "printf-calls",
+			patterns: "a/printfcalls",
+			flags:    []string{"-disable=kitlog", "-printfargs"},
+		},
+		{
+			name:     "logrus-disabled-by-default",
+			patterns: "a/logrusdefault",
+		},
+		{
+			name:     "printf-args-disabled-by-default",
+			patterns: "a/printfdefault",
+		},
+		{
+			name:     "zap-logger",
+			patterns: "a/zaplogger",
+			flags:    []string{"-requirestringkey", "-nodupkeys", "-printfargs", "-librarychecks"},
+		},
+		{
+			name:     "klog-context",
+			patterns: "a/klogcontext",
+			flags:    []string{"-nodupkeys", "-noreservedkeys", "-librarychecks"},
+		},
+		{
+			name:     "library-checks-disabled-by-default",
+			patterns: "a/librarychecks",
+		},
+		{
+			name:     "kobj",
+			patterns: "a/kobj",
+			flags:    []string{"-requirekobj"},
+		},
+		{
+			name:     "logr-coverage",
+			patterns: "a/logrcoverage",
+			flags:    []string{"-nodupkeys", "-maxvlevel", "4", "-librarychecks"},
+		},
+		{
+			name:     "slog-coverage",
+			patterns: "a/slogcoverage",
+			flags:    []string{"-nodupkeys", "-nosensitive", "-librarychecks"},
+		},
 		{
 			name:     "require-string-key",
 			patterns: "a/requirestringkey",
@@ -41,6 +91,127 @@ func TestLinter(t *testing.T) {
 			patterns: "a/noprintflike",
 			flags:    []string{"-noprintflike"},
 		},
+		{
+			name:     "no-swapped-kv",
+			patterns: "a/noswappedkv",
+			flags:    []string{"-noswappedkv"},
+		},
+		{
+			name:     "no-dup-keys",
+			patterns: "a/nodupkeys",
+			flags:    []string{"-disable=", "-nodupkeys"},
+		},
+		{
+			name:     "no-dup-keys-fold",
+			patterns: "a/nodupkeys/fold",
+			flags:    []string{"-nodupkeys", "-foldkeys"},
+		},
+		{
+			name:     "no-similar-keys",
+			patterns: "a/similarkeys",
+			flags:    []string{"-nosimilarkeys"},
+		},
+		{
+			name:     "no-similar-keys-distance",
+			patterns: "a/similarkeys/distance",
+			flags:    []string{"-nosimilarkeys", "-similarkeydistance=0"},
+		},
+		{
+			name:     "no-sensitive",
+			patterns: "a/nosensitive",
+			flags:    []string{"-nosensitive"},
+		},
+		{
+			name:     "no-sensitive-custom",
+			patterns: "a/nosensitive/custom",
+			flags:    []string{"-nosensitive", "-sensitivekeys=email,ssn", "-sensitivetypes=a/nosensitive/custom.Token"},
+		},
+		{
+			name:     "no-bad-values",
+			patterns: "a/nobadvalues",
+			flags:    []string{"-nobadvalues"},
+		},
+		{
+			name:     "no-string-values",
+			patterns: "a/nostringvalues",
+			flags:    []string{"-nostringvalues"},
+		},
+		{
+			name:     "require-const-msg",
+			patterns: "a/requireconstmsg",
+			flags:    []string{"-requireconstmsg"},
+		},
+		{
+			name:     "msg-style",
+			patterns: "a/msgstyle",
+			flags:    []string{"-msgstyle", "capitalized,nopunctuation,nonewline,nonempty", "-msgmaxlen", "40"},
+		},
+		{
+			name:     "no-kv-in-msg",
+			patterns: "a/kvinmsg",
+			flags:    []string{"-nokvinmsg"},
+		},
+		{
+			name:      "wrong-msg-style",
+			patterns:  "a/msgstyle",
+			flags:     []string{"-msgstyle", "capitalized,lowercase"},
+			wantError: `failed to parse message style: unknown message style rule: "lowercase"`,
+		},
+		{
+			name:     "no-reserved-keys",
+			patterns: "a/noreservedkeys",
+			flags:    []string{"-noreservedkeys"},
+		},
+		{
+			name:     "no-reserved-keys-custom",
+			patterns: "a/noreservedkeys/custom",
+			flags:    []string{"-noreservedkeys", "-reservedkeys", "zap=time, M ;slog=message"},
+		},
+		{
+			name:     "key-pattern",
+			patterns: "a/keystyle/pattern",
+			flags:    []string{"-keypattern", `^app\.[a-z_]+$`},
+		},
+		{
+			name:      "wrong-key-style",
+			patterns:  "a/keystyle/pattern",
+			flags:     []string{"-keystyle", "SCREAMING_CASE"},
+			wantError: "failed to parse key style",
+		},
+		{
+			name:      "wrong-key-pattern",
+			patterns:  "a/keystyle/pattern",
+			flags:     []string{"-keypattern", "["},
+			wantError: "failed to parse key style",
+		},
+		{
+			name:      "key-style-and-pattern",
+			patterns:  "a/keystyle/pattern",
+			flags:     []string{"-keystyle", "snake_case", "-keypattern", "^[a-z]+$"},
+			wantError: "key style and key pattern cannot be used together",
+		},
+		{
+			name:     "key-registry",
+			patterns: "a/keyregistry",
+			flags:    []string{"-keyregistry", "testdata/key-registry.txt"},
+		},
+		{
+			name:      "wrong-key-registry",
+			patterns:  "a/keyregistry",
+			flags:     []string{"-keyregistry", "testdata/wrong-key-registry.txt"},
+			wantError: "failed to parse key registry: error parse registry at line 2",
+		},
+		{
+			name:      "not-found-key-registry",
+			patterns:  "a/keyregistry",
+			flags:     []string{"-keyregistry", "testdata/xxxxx-wrong-key-registry-xxxxx.txt"},
+			wantError: "failed to open key registry",
+		},
+		{
+			name:     "key-packages",
+			patterns: "a/keypackages",
+			flags:    []string{"-requirestringkey", "-keypackages", "a/keypackages/logkeys,example.com/obs/logkeys"},
+		},
 		{
 			name:     "klogonly",
 			patterns: "a/klogonly",
@@ -55,6 +226,32 @@ func TestLinter(t *testing.T) {
 				"testdata/custom-rules.txt",
 			},
 		},
+		{
+			name:     "custom-message",
+			patterns: "a/custommsg",
+			flags: []string{
+				"-noprintflike",
+				"-rulefile",
+				"testdata/custom-rules-msg.txt",
+			},
+		},
+		{
+			name:     "custom-printf",
+			patterns: "a/customprintf",
+			flags: []string{
+				"-printfargs",
+				"-rulefile",
+				"testdata/custom-rules-printf.txt",
+			},
+		},
+		{
+			name:     "custom-params",
+			patterns: "a/customrules",
+			flags: []string{
+				"-rulefile",
+				"testdata/custom-rules-params.txt",
+			},
+		},
 		{
 			name:     "custom-generic",
 			patterns: "a/custom-generic",
@@ -72,6 +269,15 @@ func TestLinter(t *testing.T) {
 			},
 			wantError: rules.ErrInvalidRule.Error(),
 		},
+		{
+			name:     "wrong-rules-message",
+			patterns: "a/custommsg",
+			flags: []string{
+				"-rulefile",
+				"testdata/wrong-rules-msg.txt",
+			},
+			wantError: "error parse rule at line 2: " + rules.ErrInvalidRule.Error(),
+		},
 		{
 			name:     "not-found-rules",
 			patterns: "a/customonly",
@@ -173,6 +379,64 @@ func TestOptions(t *testing.T) {
 			},
 			patterns: "a/noprintflike",
 		},
+		{
+			name: "no-swapped-kv",
+			options: []loggercheck.Option{
+				loggercheck.WithNoSwappedKV(true),
+			},
+			patterns: "a/noswappedkv",
+		},
+		{
+			name: "no-dup-keys-fold",
+			options: []loggercheck.Option{
+				loggercheck.WithNoDupKeys(true),
+				loggercheck.WithFoldKeys(true),
+			},
+			patterns: "a/nodupkeys/fold",
+		},
+		{
+			name: "no-similar-keys-distance",
+			options: []loggercheck.Option{
+				loggercheck.WithNoSimilarKeys(true),
+				loggercheck.WithSimilarKeyDistance(0),
+			},
+			patterns: "a/similarkeys/distance",
+		},
+		{
+			name: "no-sensitive-custom",
+			options: []loggercheck.Option{
+				loggercheck.WithNoSensitive(true),
+				loggercheck.WithSensitiveKeys([]string{"email", "ssn"}),
+				loggercheck.WithSensitiveTypes([]string{"a/nosensitive/custom.Token"}),
+			},
+			patterns: "a/nosensitive/custom",
+		},
+		{
+			name: "no-reserved-keys-custom",
+			options: []loggercheck.Option{
+				loggercheck.WithNoReservedKeys(true),
+				loggercheck.WithReservedKeys(map[string][]string{
+					"zap":  {"time", "M"},
+					"slog": {"message"},
+				}),
+			},
+			patterns: "a/noreservedkeys/custom",
+		},
+		{
+			name: "key-pattern",
+			options: []loggercheck.Option{
+				loggercheck.WithKeyPattern(`^app\.[a-z_]+$`),
+			},
+			patterns: "a/keystyle/pattern",
+		},
+		{
+			name: "key-packages",
+			options: []loggercheck.Option{
+				loggercheck.WithRequireStringKey(true),
+				loggercheck.WithKeyPackages([]string{"a/keypackages/logkeys"}),
+			},
+			patterns: "a/keypackages",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -194,3 +458,162 @@ func TestOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestSuggestedFixes(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	testCases := []struct {
+		name     string
+		options  []loggercheck.Option
+		patterns string
+	}{
+		{
+			name: "key-style",
+			options: []loggercheck.Option{
+				loggercheck.WithKeyStyle("snake_case"),
+			},
+			patterns: "a/keystyle",
+		},
+		{
+			name: "key-registry",
+			options: []loggercheck.Option{
+				loggercheck.WithKeyRegistry("testdata/key-registry.txt"),
+			},
+			patterns: "a/keyregistry",
+		},
+		{
+			name:     "container-args",
+			patterns: "a/containerargs",
+		},
+		{
+			name:     "zap-sugar",
+			patterns: "a/zapsugar",
+		},
+		{
+			name:     "printf-calls",
+			patterns: "a/printfcalls",
+			options: []loggercheck.Option{
+				loggercheck.WithDisable([]string{"kitlog"}),
+				loggercheck.WithPrintfArgs(true),
+			},
+		},
+		{
+			name: "klog-context",
+			options: []loggercheck.Option{
+				loggercheck.WithNoDupKeys(true),
+				loggercheck.WithNoReservedKeys(true),
+				loggercheck.WithLibraryChecks(true),
+			},
+			patterns: "a/klogcontext",
+		},
+		{
+			name: "kobj",
+			options: []loggercheck.Option{
+				loggercheck.WithRequireKObj(true),
+			},
+			patterns: "a/kobj",
+		},
+		{
+			name: "logr-coverage",
+			options: []loggercheck.Option{
+				loggercheck.WithNoDupKeys(true),
+				loggercheck.WithMaxVLevel(4),
+				loggercheck.WithLibraryChecks(true),
+			},
+			patterns: "a/logrcoverage",
+		},
+		{
+			name: "zap-logger",
+			options: []loggercheck.Option{
+				loggercheck.WithRequireStringKey(true),
+				loggercheck.WithNoDupKeys(true),
+				loggercheck.WithPrintfArgs(true),
+				loggercheck.WithLibraryChecks(true),
+			},
+			patterns: "a/zaplogger",
+		},
+		{
+			name: "custom-printf",
+			options: []loggercheck.Option{
+				loggercheck.WithRules([]string{
+					"(*a/customprintf.Logger).Logf(printf:format)",
+					"(*a/customprintf.Logger).LogLevelf(printf:_, format)",
+					"(*a/customprintf.Logger).Log(print)",
+				}),
+				loggercheck.WithPrintfArgs(true),
+			},
+			patterns: "a/customprintf",
+		},
+		{
+			name: "no-string-values",
+			options: []loggercheck.Option{
+				loggercheck.WithNoStringValues(true),
+			},
+			patterns: "a/nostringvalues",
+		},
+		{
+			name: "require-const-msg",
+			options: []loggercheck.Option{
+				loggercheck.WithRequireConstMsg(true),
+			},
+			patterns: "a/requireconstmsg",
+		},
+		{
+			name: "msg-style",
+			options: []loggercheck.Option{
+				loggercheck.WithMsgStyle([]string{"capitalized", "nopunctuation", "nonewline", "nonempty"}),
+				loggercheck.WithMsgMaxLen(40),
+			},
+			patterns: "a/msgstyle",
+		},
+		{
+			name: "no-kv-in-msg",
+			options: []loggercheck.Option{
+				loggercheck.WithNoKVInMsg(true),
+			},
+			patterns: "a/kvinmsg",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := loggercheck.NewAnalyzer(tc.options...)
+			result := analysistest.RunWithSuggestedFixes(t, testdata, a, tc.patterns)
+			require.Len(t, result, 1)
+		})
+	}
+}
+
+func TestFactsRequired(t *testing.T) {
+	testCases := []struct {
+		name    string
+		options []loggercheck.Option
+		flags   []string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:    "no-dup-keys-option",
+			options: []loggercheck.Option{loggercheck.WithNoDupKeys(true)},
+		},
+		{
+			name:  "no-similar-keys-flag",
+			flags: []string{"-nosimilarkeys"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := loggercheck.NewAnalyzer(tc.options...)
+			require.NoError(t, a.Flags.Parse(tc.flags))
+
+			// The facts analyzer is always required, it returns immediately if the checks using facts are disabled.
+			hasFacts := false
+			for _, required := range a.Requires {
+				hasFacts = hasFacts || len(required.FactTypes) > 0
+			}
+			assert.True(t, hasFacts)
+		})
+	}
+}
@@ -29,3 +29,157 @@ func WithNoPrintfLike(noPrintfLike bool) Option {
 		l.noPrintfLike = noPrintfLike
 	}
 }
+
+func WithPrintfArgs(printfArgs bool) Option {
+	return func(l *loggercheck) {
+		l.printfArgs = printfArgs
+	}
+}
+
+func WithNoSwappedKV(noSwappedKV bool) Option {
+	return func(l *loggercheck) {
+		l.noSwappedKV = noSwappedKV
+	}
+}
+
+func WithNoDupKeys(noDupKeys bool) Option {
+	return func(l *loggercheck) {
+		l.noDupKeys = noDupKeys
+	}
+}
+
+func WithFoldKeys(foldKeys bool) Option {
+	return func(l *loggercheck) {
+		l.foldKeys = foldKeys
+	}
+}
+
+func WithNoReservedKeys(noReservedKeys bool) Option {
+	return func(l *loggercheck) {
+		l.noReservedKeys = noReservedKeys
+	}
+}
+
+// WithReservedKeys overrides the reserved keys of logger checkers, for example: {"zap": {"ts", "level", "msg"}}.
+func WithReservedKeys(reservedKeys map[string][]string) Option {
+	return func(l *loggercheck) {
+		l.reservedKeys = reservedKeys
+	}
+}
+
+// WithKeyStyle sets the naming convention of logging keys: snake_case, lowerCamelCase, kebab-case or dotted.
+func WithKeyStyle(keyStyle string) Option {
+	return func(l *loggercheck) {
+		l.keyStyleName = keyStyle
+	}
+}
+
+// WithKeyPattern requires logging keys to match the regular expression.
+func WithKeyPattern(keyPattern string) Option {
+	return func(l *loggercheck) {
+		l.keyPattern = keyPattern
+	}
+}
+
+// WithKeyRegistry sets the path to the file contains the allowed logging keys.
+func WithKeyRegistry(keyRegistryFile string) Option {
+	return func(l *loggercheck) {
+		l.keyRegistryFile = keyRegistryFile
+	}
+}
+
+// WithKeyPackages allows logging keys declared in the packages, even if they are variables.
+func WithKeyPackages(keyPackages []string) Option {
+	return func(l *loggercheck) {
+		l.keyPackages = sets.NewString(keyPackages...)
+	}
+}
+
+func WithNoSimilarKeys(noSimilarKeys bool) Option {
+	return func(l *loggercheck) {
+		l.noSimilarKeys = noSimilarKeys
+	}
+}
+
+// WithSimilarKeyDistance sets the maximal edit distance of similar logging keys, 0 only reports keys
+// which differ in case or separator.
+func WithSimilarKeyDistance(distance int) Option {
+	return func(l *loggercheck) {
+		l.similarKeyDistance = distance
+	}
+}
+
+func WithNoSensitive(noSensitive bool) Option {
+	return func(l *loggercheck) {
+		l.noSensitive = noSensitive
+	}
+}
+
+// WithSensitiveKeys overrides the default list of sensitive logging keys.
+func WithSensitiveKeys(sensitiveKeys []string) Option {
+	return func(l *loggercheck) {
+		l.sensitiveKeys = sets.NewString(sensitiveKeys...)
+	}
+}
+
+// WithSensitiveTypes sets the qualified names of sensitive types, for example "example.com/pkg.Token".
+func WithSensitiveTypes(sensitiveTypes []string) Option {
+	return func(l *loggercheck) {
+		l.sensitiveTypes = sets.NewString(sensitiveTypes...)
+	}
+}
+
+func WithNoBadValues(noBadValues bool) Option {
+	return func(l *loggercheck) {
+		l.noBadValues = noBadValues
+	}
+}
+
+func WithNoStringValues(noStringValues bool) Option {
+	return func(l *loggercheck) {
+		l.noStringValues = noStringValues
+	}
+}
+
+func WithRequireConstMsg(requireConstMsg bool) Option {
+	return func(l *loggercheck) {
+		l.requireConstMsg = requireConstMsg
+	}
+}
+
+// WithMsgStyle sets the logging message style rules: capitalized, nonempty, nonewline and nopunctuation.
+func WithMsgStyle(rules []string) Option {
+	return func(l *loggercheck) {
+		l.msgStyleRules = sets.NewString(rules...)
+	}
+}
+
+func WithMsgMaxLen(maxLen int) Option {
+	return func(l *loggercheck) {
+		l.msgMaxLen = maxLen
+	}
+}
+
+func WithNoKVInMsg(noKVInMsg bool) Option {
+	return func(l *loggercheck) {
+		l.noKVInMsg = noKVInMsg
+	}
+}
+
+func WithRequireKObj(requireKObj bool) Option {
+	return func(l *loggercheck) {
+		l.requireKObj = requireKObj
+	}
+}
+
+func WithLibraryChecks(libraryChecks bool) Option {
+	return func(l *loggercheck) {
+		l.libraryChecks = libraryChecks
+	}
+}
+
+func WithMaxVLevel(maxVLevel int) Option {
+	return func(l *loggercheck) {
+		l.maxVLevel = maxVLevel
+	}
+}
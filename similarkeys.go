@@ -0,0 +1,191 @@
+package loggercheck
+
+import (
+	"fmt"
+	"go/ast"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/timonwong/loggercheck/internal/checkers"
+	"github.com/timonwong/loggercheck/internal/stringutil"
+)
+
+// minSimilarKeyLen is the minimal length of normalized keys compared by edit distance,
+// short keys like "id" and "ip" are too close to tell typos from different concepts.
+const minSimilarKeyLen = 4
+
+// packageKeysFact records how many times each constant logging key is used in a package.
+type packageKeysFact struct {
+	Keys map[string]int
+}
+
+func (*packageKeysFact) AFact() {}
+
+func (f *packageKeysFact) String() string {
+	names := make([]string, 0, len(f.Keys))
+	for name := range f.Keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		names[i] = fmt.Sprintf("%s:%d", name, f.Keys[name])
+	}
+	return "packageKeys(" + strings.Join(names, ",") + ")"
+}
+
+// keyUsage records the uses of a logging key in the current package and its dependencies.
+type keyUsage struct {
+	count int
+	local []checkers.Key // uses in the current package
+	pkgs  []string       // paths of dependencies using the key
+}
+
+// keyUsages maps logging keys to their uses.
+type keyUsages map[string]*keyUsage
+
+func (u keyUsages) get(name string) *keyUsage {
+	usage := u[name]
+	if usage == nil {
+		usage = &keyUsage{}
+		u[name] = usage
+	}
+	return usage
+}
+
+// collectKeyUsages collects constant logging keys of the package and exports their counts as a fact,
+// the counts exported by dependencies are merged into the result.
+func (l *loggercheck) collectKeyUsages(pass *analysis.Pass, insp *inspector.Inspector) keyUsages {
+	usages := make(keyUsages)
+	counts := make(map[string]int)
+
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+	}
+	insp.Preorder(nodeFilter, func(node ast.Node) {
+		checker, callCtx, ok := l.resolveCall(pass, node.(*ast.CallExpr))
+		if !ok {
+			return
+		}
+
+		for _, key := range checkers.LoggingKeys(checker, pass, callCtx) {
+			usage := usages.get(key.Name)
+			usage.count++
+			usage.local = append(usage.local, key)
+			counts[key.Name]++
+		}
+	})
+
+	if len(counts) > 0 {
+		pass.ExportPackageFact(&packageKeysFact{Keys: counts})
+	}
+
+	for _, pkgFact := range pass.AllPackageFacts() {
+		fact, ok := pkgFact.Fact.(*packageKeysFact)
+		if !ok || pkgFact.Package == pass.Pkg {
+			continue
+		}
+
+		for name, n := range fact.Keys {
+			usage := usages.get(name)
+			usage.count += n
+			usage.pkgs = append(usage.pkgs, pkgFact.Package.Path())
+		}
+	}
+
+	for _, usage := range usages {
+		sort.Strings(usage.pkgs)
+	}
+	return usages
+}
+
+// checkSimilarKeys reports keys of the current package which normalize to the same form as a more common key,
+// or are within a small edit distance of it. For example: "userId" while "user_id" is used everywhere else.
+func (l *loggercheck) checkSimilarKeys(pass *analysis.Pass) {
+	usages := pass.ResultOf[l.facts].(*factsResult).keys
+
+	names := make([]string, 0, len(usages))
+	for name := range usages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		usage := usages[name]
+		if len(usage.local) == 0 {
+			continue
+		}
+
+		other, ok := l.mostCommonSimilarKey(usages, names, name)
+		if !ok {
+			continue
+		}
+
+		message := fmt.Sprintf("logging key %q is similar to the more common key %q", name, other)
+		if pkgs := usages[other].pkgs; len(pkgs) > 0 {
+			message += ", used in " + strings.Join(pkgs, ", ")
+		}
+
+		var related []analysis.RelatedInformation
+		for _, key := range usages[other].local {
+			related = append(related, analysis.RelatedInformation{
+				Pos:     key.Expr.Pos(),
+				End:     key.Expr.End(),
+				Message: fmt.Sprintf("key %q used here", other),
+			})
+		}
+
+		for _, key := range usage.local {
+			pass.Report(analysis.Diagnostic{
+				Pos:      key.Expr.Pos(),
+				End:      key.Expr.End(),
+				Category: checkers.DiagnosticCategory,
+				Message:  message,
+				Related:  related,
+			})
+		}
+	}
+}
+
+// mostCommonSimilarKey returns the most common key similar to the given one, which is used more often.
+// Keys only differing in case or separator which are used equally often are ordered by name,
+// so only one of them is reported.
+func (l *loggercheck) mostCommonSimilarKey(usages keyUsages, names []string, name string) (string, bool) {
+	normalized := stringutil.NormalizeKey(name)
+	count := usages[name].count
+
+	var best string
+	bestCount := 0
+	for _, other := range names {
+		if other == name {
+			continue
+		}
+
+		otherCount := usages[other].count
+		otherNormalized := stringutil.NormalizeKey(other)
+		switch {
+		case otherNormalized == normalized:
+			if otherCount < count || (otherCount == count && other > name) {
+				continue
+			}
+		case otherCount > count && l.isCloseKey(normalized, otherNormalized):
+		default:
+			continue
+		}
+
+		if otherCount > bestCount {
+			best, bestCount = other, otherCount
+		}
+	}
+	return best, bestCount > 0
+}
+
+func (l *loggercheck) isCloseKey(a, b string) bool {
+	if l.similarKeyDistance <= 0 || len(a) < minSimilarKeyLen || len(b) < minSimilarKeyLen {
+		return false
+	}
+	return stringutil.EditDistance(a, b) <= l.similarKeyDistance
+}
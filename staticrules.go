@@ -11,27 +11,119 @@ import (
 var (
 	staticRuleList = []rules.Ruleset{
 		mustNewStaticRuleSet("logr", []string{
-			"(github.com/go-logr/logr.Logger).Error",
-			"(github.com/go-logr/logr.Logger).Info",
+			"(github.com/go-logr/logr.Logger).Error(_, msg)",
+			"(github.com/go-logr/logr.Logger).Info(msg)",
 			"(github.com/go-logr/logr.Logger).WithValues",
+			"(github.com/go-logr/logr.Logger).WithName",
+			"(github.com/go-logr/logr.Logger).WithCallDepth",
+			"(github.com/go-logr/logr.Logger).V",
+
+			"github.com/go-logr/logr.NewContext",
+			"github.com/go-logr/logr.FromContext",
+			"github.com/go-logr/logr.FromContextOrDiscard",
+		}),
+		mustNewStaticRuleSet("logr", []string{
+			"github.com/go-logr/logr/funcr.New",
+			"github.com/go-logr/logr/funcr.NewJSON",
+		}),
+		mustNewStaticRuleSet("logr", []string{
+			"github.com/go-logr/logr/testr.New",
+			"github.com/go-logr/logr/testr.NewWithOptions",
+			"github.com/go-logr/logr/testr.NewWithInterface",
+		}),
+		mustNewStaticRuleSet("klog", []string{
+			"k8s.io/klog/v2.InfoS(msg)",
+			"k8s.io/klog/v2.InfoSDepth(_, msg)",
+			"k8s.io/klog/v2.ErrorS(_, msg)",
+			"k8s.io/klog/v2.ErrorSDepth(_, _, msg)",
+			"(k8s.io/klog/v2.Verbose).InfoS(msg)",
+			"(k8s.io/klog/v2.Verbose).InfoSDepth(_, msg)",
+			"(k8s.io/klog/v2.Verbose).ErrorS(_, msg)",
+
+			// Contextual logging, the methods of klog.Logger are checked by the logr ruleset.
+			"k8s.io/klog/v2.LoggerWithValues",
+			"k8s.io/klog/v2.LoggerWithName",
+			"k8s.io/klog/v2.NewContext",
+			"k8s.io/klog/v2.FromContext",
+
+			"k8s.io/klog/v2.KObj",
+			"k8s.io/klog/v2.KObjSlice",
+			"k8s.io/klog/v2.KRef",
+
+			"k8s.io/klog/v2.Infof(printf:format)",
+			"k8s.io/klog/v2.InfofDepth(printf:_, format)",
+			"k8s.io/klog/v2.Warningf(printf:format)",
+			"k8s.io/klog/v2.WarningfDepth(printf:_, format)",
+			"k8s.io/klog/v2.Errorf(printf:format)",
+			"k8s.io/klog/v2.ErrorfDepth(printf:_, format)",
+			"k8s.io/klog/v2.Fatalf(printf:format)",
+			"k8s.io/klog/v2.FatalfDepth(printf:_, format)",
+			"k8s.io/klog/v2.Exitf(printf:format)",
+			"k8s.io/klog/v2.ExitfDepth(printf:_, format)",
+			"(k8s.io/klog/v2.Verbose).Infof(printf:format)",
+			"(k8s.io/klog/v2.Verbose).InfofDepth(printf:_, format)",
 		}),
 		mustNewStaticRuleSet("klog", []string{
-			"k8s.io/klog/v2.InfoS",
-			"k8s.io/klog/v2.InfoSDepth",
-			"k8s.io/klog/v2.ErrorS",
-			"(k8s.io/klog/v2.Verbose).InfoS",
-			"(k8s.io/klog/v2.Verbose).InfoSDepth",
-			"(k8s.io/klog/v2.Verbose).ErrorS",
+			"k8s.io/klog/v2/ktesting.NewTestContext",
+			"k8s.io/klog/v2/ktesting.NewLogger",
+			"k8s.io/klog/v2/ktesting.Verbosity",
 		}),
 		mustNewStaticRuleSet("zap", []string{
+			"(*go.uber.org/zap.Logger).With",
+			"(*go.uber.org/zap.Logger).WithLazy",
+			"(*go.uber.org/zap.Logger).Named",
+			"(*go.uber.org/zap.Logger).Debug(msg)",
+			"(*go.uber.org/zap.Logger).Info(msg)",
+			"(*go.uber.org/zap.Logger).Warn(msg)",
+			"(*go.uber.org/zap.Logger).Error(msg)",
+			"(*go.uber.org/zap.Logger).DPanic(msg)",
+			"(*go.uber.org/zap.Logger).Panic(msg)",
+			"(*go.uber.org/zap.Logger).Fatal(msg)",
+			"(*go.uber.org/zap.Logger).Log(_, msg)",
+			"(*go.uber.org/zap.Logger).Check(_, msg)",
+
 			"(*go.uber.org/zap.SugaredLogger).With",
-			"(*go.uber.org/zap.SugaredLogger).Debugw",
-			"(*go.uber.org/zap.SugaredLogger).Infow",
-			"(*go.uber.org/zap.SugaredLogger).Warnw",
-			"(*go.uber.org/zap.SugaredLogger).Errorw",
-			"(*go.uber.org/zap.SugaredLogger).DPanicw",
-			"(*go.uber.org/zap.SugaredLogger).Panicw",
-			"(*go.uber.org/zap.SugaredLogger).Fatalw",
+			"(*go.uber.org/zap.SugaredLogger).WithLazy",
+			"(*go.uber.org/zap.SugaredLogger).Named",
+			"(*go.uber.org/zap.SugaredLogger).Debugw(msg)",
+			"(*go.uber.org/zap.SugaredLogger).Infow(msg)",
+			"(*go.uber.org/zap.SugaredLogger).Warnw(msg)",
+			"(*go.uber.org/zap.SugaredLogger).Errorw(msg)",
+			"(*go.uber.org/zap.SugaredLogger).DPanicw(msg)",
+			"(*go.uber.org/zap.SugaredLogger).Panicw(msg)",
+			"(*go.uber.org/zap.SugaredLogger).Fatalw(msg)",
+			"(*go.uber.org/zap.SugaredLogger).Logw(_, msg)",
+
+			"(*go.uber.org/zap.SugaredLogger).Debug(print)",
+			"(*go.uber.org/zap.SugaredLogger).Info(print)",
+			"(*go.uber.org/zap.SugaredLogger).Warn(print)",
+			"(*go.uber.org/zap.SugaredLogger).Error(print)",
+			"(*go.uber.org/zap.SugaredLogger).DPanic(print)",
+			"(*go.uber.org/zap.SugaredLogger).Panic(print)",
+			"(*go.uber.org/zap.SugaredLogger).Fatal(print)",
+			"(*go.uber.org/zap.SugaredLogger).Log(print)",
+
+			"(*go.uber.org/zap.SugaredLogger).Debugf(printf:format)",
+			"(*go.uber.org/zap.SugaredLogger).Infof(printf:format)",
+			"(*go.uber.org/zap.SugaredLogger).Warnf(printf:format)",
+			"(*go.uber.org/zap.SugaredLogger).Errorf(printf:format)",
+			"(*go.uber.org/zap.SugaredLogger).DPanicf(printf:format)",
+			"(*go.uber.org/zap.SugaredLogger).Panicf(printf:format)",
+			"(*go.uber.org/zap.SugaredLogger).Fatalf(printf:format)",
+			"(*go.uber.org/zap.SugaredLogger).Logf(printf:_, format)",
+
+			"(*go.uber.org/zap.SugaredLogger).Debugln(println)",
+			"(*go.uber.org/zap.SugaredLogger).Infoln(println)",
+			"(*go.uber.org/zap.SugaredLogger).Warnln(println)",
+			"(*go.uber.org/zap.SugaredLogger).Errorln(println)",
+			"(*go.uber.org/zap.SugaredLogger).DPanicln(println)",
+			"(*go.uber.org/zap.SugaredLogger).Panicln(println)",
+			"(*go.uber.org/zap.SugaredLogger).Fatalln(println)",
+			"(*go.uber.org/zap.SugaredLogger).Logln(println)",
+		}),
+		mustNewStaticRuleSet("zap", []string{
+			// Fields written with the entry returned by (*zap.Logger).Check.
+			"(*go.uber.org/zap/zapcore.CheckedEntry).Write",
 		}),
 		mustNewStaticRuleSet("kitlog", []string{
 			"github.com/go-kit/log.With",
@@ -39,36 +131,91 @@ var (
 			"github.com/go-kit/log.WithSuffix",
 			"(github.com/go-kit/log.Logger).Log",
 		}),
+		mustNewStaticRuleSet("logrus", []string{
+			"github.com/sirupsen/logrus.Tracef(printf:format)",
+			"github.com/sirupsen/logrus.Debugf(printf:format)",
+			"github.com/sirupsen/logrus.Printf(printf:format)",
+			"github.com/sirupsen/logrus.Infof(printf:format)",
+			"github.com/sirupsen/logrus.Warnf(printf:format)",
+			"github.com/sirupsen/logrus.Warningf(printf:format)",
+			"github.com/sirupsen/logrus.Errorf(printf:format)",
+			"github.com/sirupsen/logrus.Fatalf(printf:format)",
+			"github.com/sirupsen/logrus.Panicf(printf:format)",
+
+			"(*github.com/sirupsen/logrus.Logger).Tracef(printf:format)",
+			"(*github.com/sirupsen/logrus.Logger).Debugf(printf:format)",
+			"(*github.com/sirupsen/logrus.Logger).Printf(printf:format)",
+			"(*github.com/sirupsen/logrus.Logger).Infof(printf:format)",
+			"(*github.com/sirupsen/logrus.Logger).Warnf(printf:format)",
+			"(*github.com/sirupsen/logrus.Logger).Warningf(printf:format)",
+			"(*github.com/sirupsen/logrus.Logger).Errorf(printf:format)",
+			"(*github.com/sirupsen/logrus.Logger).Fatalf(printf:format)",
+			"(*github.com/sirupsen/logrus.Logger).Panicf(printf:format)",
+			"(*github.com/sirupsen/logrus.Logger).Logf(printf:_, format)",
+
+			"(*github.com/sirupsen/logrus.Entry).Tracef(printf:format)",
+			"(*github.com/sirupsen/logrus.Entry).Debugf(printf:format)",
+			"(*github.com/sirupsen/logrus.Entry).Printf(printf:format)",
+			"(*github.com/sirupsen/logrus.Entry).Infof(printf:format)",
+			"(*github.com/sirupsen/logrus.Entry).Warnf(printf:format)",
+			"(*github.com/sirupsen/logrus.Entry).Warningf(printf:format)",
+			"(*github.com/sirupsen/logrus.Entry).Errorf(printf:format)",
+			"(*github.com/sirupsen/logrus.Entry).Fatalf(printf:format)",
+			"(*github.com/sirupsen/logrus.Entry).Panicf(printf:format)",
+			"(*github.com/sirupsen/logrus.Entry).Logf(printf:_, format)",
+		}),
 		mustNewStaticRuleSet("slog", []string{
 			"log/slog.Group",
+			"log/slog.GroupAttrs",
+			"log/slog.GroupValue",
 
 			"log/slog.With",
 
-			"log/slog.Debug",
-			"log/slog.Info",
-			"log/slog.Warn",
-			"log/slog.Error",
+			"log/slog.Debug(msg)",
+			"log/slog.Info(msg)",
+			"log/slog.Warn(msg)",
+			"log/slog.Error(msg)",
+
+			"log/slog.DebugContext(_, msg)",
+			"log/slog.InfoContext(_, msg)",
+			"log/slog.WarnContext(_, msg)",
+			"log/slog.ErrorContext(_, msg)",
+
+			"log/slog.Log(_, _, msg)",
+			"log/slog.LogAttrs(_, _, msg)",
 
-			"log/slog.DebugContext",
-			"log/slog.InfoContext",
-			"log/slog.WarnContext",
-			"log/slog.ErrorContext",
+			"log/slog.NewRecord(_, _, msg)",
+			"(*log/slog.Record).Add",
+			"(*log/slog.Record).AddAttrs",
 
 			"(*log/slog.Logger).With",
+			"(*log/slog.Logger).WithGroup",
+
+			"(*log/slog.Logger).Debug(msg)",
+			"(*log/slog.Logger).Info(msg)",
+			"(*log/slog.Logger).Warn(msg)",
+			"(*log/slog.Logger).Error(msg)",
+
+			"(*log/slog.Logger).DebugContext(_, msg)",
+			"(*log/slog.Logger).InfoContext(_, msg)",
+			"(*log/slog.Logger).WarnContext(_, msg)",
+			"(*log/slog.Logger).ErrorContext(_, msg)",
 
-			"(*log/slog.Logger).Debug",
-			"(*log/slog.Logger).Info",
-			"(*log/slog.Logger).Warn",
-			"(*log/slog.Logger).Error",
+			"(*log/slog.Logger).Log(_, _, msg)",
+			"(*log/slog.Logger).LogAttrs(_, _, msg)",
 
-			"(*log/slog.Logger).DebugContext",
-			"(*log/slog.Logger).InfoContext",
-			"(*log/slog.Logger).WarnContext",
-			"(*log/slog.Logger).ErrorContext",
+			"(log/slog.Handler).WithAttrs",
+			"(log/slog.Handler).WithGroup",
+			"(*log/slog.JSONHandler).WithAttrs",
+			"(*log/slog.JSONHandler).WithGroup",
+			"(*log/slog.TextHandler).WithAttrs",
+			"(*log/slog.TextHandler).WithGroup",
 		}),
 	}
 	checkerByRulesetName = map[string]checkers.Checker{
 		// by default, checkers.General will be used.
+		"klog": checkers.Klog{},
+		"logr": checkers.Logr{},
 		"zap":  checkers.Zap{},
 		"slog": checkers.Slog{},
 	}
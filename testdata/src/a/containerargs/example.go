@@ -0,0 +1,34 @@
+package containerargs
+
+import (
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+type keyValues []interface{}
+
+func ExampleSliceArgs(logger logr.Logger, kvs []interface{}, anys []any, names []string, custom keyValues) {
+	logger.Info("message", kvs)             // want `slice kvs is passed as a single logging argument, use kvs\.\.\. instead`
+	slog.Info("message", anys)              // want `slice anys is passed as a single logging argument, use anys\.\.\. instead`
+	logger.Info("message", "key", 1, kvs)   // want `slice kvs is passed as a single logging argument, its elements are not expanded into key-value pairs`
+	logger.Info("message", kvs, "key")      // want `slice kvs is passed as a single logging argument, its elements are not expanded into key-value pairs`
+	slog.Info("message", names)             // want `slice names is passed as a single logging argument, its elements are not expanded into key-value pairs`
+	slog.Info("message", custom)            // want `slice custom is passed as a single logging argument, its elements are not expanded into key-value pairs`
+	zap.S().Infow("message", []zap.Field{}) // want `slice \[\]zap\.Field\{\} is passed as a single logging argument, its elements are not expanded into key-value pairs`
+
+	logger.Info("message", kvs...)
+	logger.Info("message", "names", names, "kvs", kvs)
+}
+
+func ExampleMapArgs(logger logr.Logger, fields map[string]interface{}) {
+	logger.Info("message", fields)           // want `map fields is passed as a single logging argument, its entries are not expanded into key-value pairs`
+	slog.Info("message", fields, "key", "v") // want `map fields is passed as a single logging argument, its entries are not expanded into key-value pairs`
+
+	logger.Info("message", "fields", fields)
+}
+
+func ExampleByteSlice(logger logr.Logger, key []byte) {
+	logger.Info("message", key, "value")
+}
@@ -0,0 +1,20 @@
+package custommsg
+
+type Logger struct{}
+
+func (l *Logger) Infow(msg string, keysAndValues ...interface{})                 {}
+func (l *Logger) Errorw(err error, message string, keysAndValues ...interface{}) {}
+func (l *Logger) Debugw(msg string, keysAndValues ...interface{})                {}
+func (l *Logger) Warnw(msg string, keysAndValues ...interface{})                 {}
+
+func ExampleMessageParam(l *Logger, err error) {
+	l.Infow("user %s logged in", "user", "x")       // want `logging message should not use format specifier "%s"`
+	l.Errorw(err, "request %d failed", "id", "1%d") // want `logging message should not use format specifier "%d"`
+	l.Infow("user logged in", "user", "%s")
+
+	// No message parameter declared, all the arguments are checked
+	l.Debugw("user %s logged in", "user", "x") // want `logging message should not use format specifier "%s"`
+	l.Debugw("user logged in", "user", "1%d")  // want `logging message should not use format specifier "%d"`
+
+	l.Warnw("user %s logged in", "user", "x") // want `the rule of \(\*a/custommsg.Logger\).Warnw declares the message as parameter 3, the function has 2 parameters`
+}
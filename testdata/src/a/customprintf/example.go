@@ -0,0 +1,15 @@
+package customprintf
+
+type Logger struct{}
+
+func (l *Logger) Logf(format string, args ...interface{})                 {}
+func (l *Logger) LogLevelf(level int, format string, args ...interface{}) {}
+func (l *Logger) Log(args ...interface{})                                 {}
+
+func ExamplePrintf(l *Logger, name string, n int) {
+	l.Logf("user %s logged in %d times", name) // want `Logf format %d reads arg #2, but call has 1 args`
+	l.LogLevelf(1, "user %d logged in", name)  // want `LogLevelf format %d has arg name of wrong type string`
+	l.Logf("request failed: %w", nil)          // want `Logf does not support error-wrapping directive %w, use %v instead`
+	l.Log("user logged in", "user", name)      // want `arguments of Log look like key-value pairs, but they are formatted$`
+	l.Logf("user %s logged in %d times", name, n)
+}
@@ -0,0 +1,15 @@
+package customrules
+
+type Logger struct{}
+
+func (l *Logger) Flush()                                         {}
+func (l *Logger) Log(msg string, level int)                      {}
+func (l *Logger) Infow(msg string, keysAndValues ...interface{}) {}
+
+// Rules may match functions without parameters, or without key-value pairs.
+func ExampleParams(l *Logger, kvs []interface{}) {
+	l.Flush()
+	l.Log("message", 1)
+	l.Infow("message", "key") // want `odd number of arguments passed as key-value pairs for logging`
+	l.Infow("message", kvs)   // want `slice kvs is passed as a single logging argument, use kvs... instead`
+}
@@ -0,0 +1,16 @@
+package keypackages
+
+import (
+	"github.com/go-logr/logr"
+
+	"a/keypackages/logkeys"
+)
+
+var localKey = "local"
+
+func ExampleKeyPackages() {
+	log := logr.Discard()
+
+	log.Info("message", logkeys.User, 1, logkeys.RequestID, "abc", logkeys.Component, "db")
+	log.Info("message", localKey, 1) // want `logging keys are expected to be inlined constant strings, please replace "localKey" provided with string`
+}
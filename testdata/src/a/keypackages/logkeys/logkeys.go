@@ -0,0 +1,10 @@
+package logkeys
+
+type LogKey string
+
+const User LogKey = "user"
+
+var (
+	RequestID        = "request_id"
+	Component LogKey = "component"
+)
@@ -0,0 +1,33 @@
+package keyregistry
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+type Payload struct{}
+
+type UserID int64
+
+func ExampleKeyRegistry(userID int64, start time.Time) {
+	log := logr.Discard()
+
+	log.Info("message", "request_id", "abc", "user_id", userID, "duration", time.Since(start))
+	log.Info("message", "user_id", 42, "duration", 5*time.Second)
+	log.Info("message", "err", errors.New("x"), "payload", &Payload{}, "tags", map[string]string{})
+
+	log.Info("message", "usr_id", userID)                            // want `logging key "usr_id" is not in the key registry, did you mean "user_id"\?`
+	log.Info("message", "latency", time.Since(start))                // want `logging key "latency" is not in the key registry`
+	log.Info("message", "user_id", "42")                             // want `logging key "user_id" expects a value of type int64, got string`
+	log.Info("message", "user_id", int(userID))                      // want `logging key "user_id" expects a value of type int64, got int`
+	log.Info("message", "user_id", UserID(userID))                   // want `logging key "user_id" expects a value of type int64, got a/keyregistry.UserID`
+	log.Info("message", "duration", time.Since(start).Seconds())     // want `logging key "duration" expects a value of type time.Duration, got float64`
+	log.Info("message", "payload", Payload{}, "tags", []string{"a"}) // want `logging key "payload" expects a value of type \*a/keyregistry.Payload, got a/keyregistry.Payload` `logging key "tags" expects a value of type map\[string\]string, got \[\]string`
+
+	slog.Info("message", slog.Int64("user_id", userID), slog.String("requestid", "abc")) // want `logging key "requestid" is not in the key registry, did you mean "request_id"\?`
+	zap.S().Infow("message", zap.Duration("durations", time.Second))                     // want `logging key "durations" is not in the key registry, did you mean "duration"\?`
+}
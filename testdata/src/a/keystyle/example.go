@@ -0,0 +1,25 @@
+package keystyle
+
+import (
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+const KeyUserID = "userID"
+
+func ExampleKeyStyle() {
+	log := logr.Discard()
+
+	log.Info("message", "user_id", 1, "http_status2", 200)
+	log.Info("message", "userID", 1)               // want `logging key "userID" does not follow the key style "snake_case", use "user_id" instead`
+	log.Info("message", "HTTPStatus", 1, "a.b", 2) // want `logging key "HTTPStatus" does not follow the key style "snake_case", use "http_status" instead` `logging key "a.b" does not follow the key style "snake_case", use "a_b" instead`
+	log.Info("message", KeyUserID, 1)              // want `logging key "userID" does not follow the key style "snake_case", use "user_id" instead`
+	log.Info("message", "键", 1)                    // want `logging key "键" does not follow the key style "snake_case"`
+	log.WithValues("requestID", 1).Info("message") // want `logging key "requestID" does not follow the key style "snake_case", use "request_id" instead`
+
+	slog.Info("message", slog.String("userName", "x"), slog.Group("Request", "user_id", 1)) // want `logging key "userName" does not follow the key style "snake_case", use "user_name" instead` `logging key "Request" does not follow the key style "snake_case", use "request" instead`
+
+	zap.S().Infow("message", zap.Int("userID", 1), "user-name", "x") // want `logging key "userID" does not follow the key style "snake_case", use "user_id" instead` `logging key "user-name" does not follow the key style "snake_case", use "user_name" instead`
+}
@@ -0,0 +1,12 @@
+package pattern
+
+import (
+	"github.com/go-logr/logr"
+)
+
+func ExampleKeyPattern() {
+	log := logr.Discard()
+
+	log.Info("message", "app.user_id", 1)
+	log.Info("message", "user_id", 1) // want `logging key "user_id" does not follow the key style ".+"`
+}
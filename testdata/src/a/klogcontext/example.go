@@ -0,0 +1,68 @@
+package klogcontext
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/klog/v2"
+	"k8s.io/klog/v2/ktesting"
+)
+
+type Pod struct {
+	Name, Namespace string
+}
+
+func (p *Pod) GetName() string      { return p.Name }
+func (p *Pod) GetNamespace() string { return p.Namespace }
+
+func ExampleStructured(pod *Pod) {
+	err := errors.New("failed")
+
+	klog.InfoSDepth(1, "message", "key1", "value1", "key2") // want `odd number of arguments passed as key-value pairs for logging`
+	klog.ErrorSDepth(1, err, "message", "key1")             // want `odd number of arguments passed as key-value pairs for logging`
+	klog.ErrorSDepth(1, err, "message", "err", err)         // want `logging key "err" collides with the key reserved by the logger`
+
+	klog.InfoS("retrying", "err", err)
+	klog.InfoS("retrying", "error", err)    // want `error is logged with key "error", klog logs errors with key "err"`
+	klog.V(2).InfoS("retrying", "Err", err) // want `error is logged with key "Err", klog logs errors with key "err"`
+	klog.ErrorS(err, "retrying", "error", errors.New("x"))
+	klog.InfoS("retrying", "error", "connection refused") // not an error value
+
+	klog.InfoS("pod updated", "pod", klog.KObj(pod), "owner", klog.KRef("default", "owner"))
+	klog.InfoS("pod updated", "pod", klog.KRef("default", "")) // want `KRef is called with an empty name, the namespace is passed first: KRef\(namespace, name\)`
+	klog.InfoS("pod updated", "node", klog.KRef("", "node-1"))
+
+	klog.InfoS("pods updated", "pods", klog.KObjSlice([]*Pod{pod}))
+	klog.InfoS("pods updated", "pods", klog.KObjSlice([]Pod{*pod})) // want `KObjSlice needs a slice of values implementing KMetadata, got type Pod, the error is logged in place of the objects`
+	klog.InfoS("pods updated", "pods", klog.KObjSlice(pod))         // want `KObjSlice needs a slice, got type \*Pod, the error is logged in place of the objects`
+	klog.InfoS("pods updated", "pods", klog.KObjSlice(nil))
+}
+
+func ExampleContextual(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+
+	_ = klog.LoggerWithValues(logger, "controller", "deployment", "worker") // want `odd number of arguments passed as key-value pairs for logging`
+	logger = klog.LoggerWithValues(logger, "controller", "deployment")
+	logger.Info("message", "controller", "replicaset") // want `duplicate logging key "controller", it is already bound to the logger`
+
+	named := klog.LoggerWithName(logger, "sync")
+	named.Info("message", "controller", "replicaset") // want `duplicate logging key "controller", it is already bound to the logger`
+	_ = klog.LoggerWithName(logger, "")               // want `LoggerWithName is called with an empty name, it adds an empty element to the logger name`
+
+	ctx = klog.NewContext(ctx, logger)
+	klog.FromContext(ctx).Info("message", "controller", "replicaset") // want `duplicate logging key "controller", it is already bound to the logger`
+	klog.FromContext(context.Background()).Info("message", "controller", "replicaset")
+}
+
+func ExampleTesting(t *testing.T) {
+	logger, ctx := ktesting.NewTestContext(t)
+	logger.Info("message", "key1") // want `odd number of arguments passed as key-value pairs for logging`
+	klog.FromContext(ctx).Info("message", "key1", "value1")
+
+	config := ktesting.NewConfig(ktesting.Verbosity(-1)) // want `ktesting.Verbosity is called with negative level -1, the test logger drops all the info messages`
+	logger = ktesting.NewLogger(t, config)
+	logger = klog.LoggerWithValues(logger, "test", t.Name())
+	logger.Info("message", "test", "again") // want `duplicate logging key "test", it is already bound to the logger`
+	_ = ktesting.NewConfig(ktesting.Verbosity(5))
+}
@@ -0,0 +1,44 @@
+package kobj
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+)
+
+type Pod struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec PodSpec
+}
+
+type PodSpec struct {
+	NodeName string
+}
+
+type Controller struct {
+	pod *Pod
+}
+
+func ExampleKlog(pod *Pod, value Pod, pods []*Pod, c *Controller, obj *unstructured.Unstructured) {
+	klog.InfoS("pod synced", "pod", pod)         // want `Kubernetes object of type \*Pod is logged in full, log its reference with klog.KObj`
+	klog.InfoS("pod synced", "pod", *pod)        // want `Kubernetes object of type Pod is logged in full, log its reference with klog.KRef`
+	klog.InfoS("pod synced", "pod", value)       // want `Kubernetes object of type Pod is logged in full, log its reference with klog.KRef`
+	klog.InfoS("pod synced", "pod", c.pod)       // want `Kubernetes object of type \*Pod is logged in full, log its reference with klog.KObj`
+	klog.InfoS("pods synced", "pods", pods)      // want `Kubernetes object of type \[\]\*Pod is logged in full, log its reference with klog.KObjSlice`
+	klog.V(2).InfoS("object synced", "obj", obj) // want `Kubernetes object of type \*.*/unstructured.Unstructured is logged in full, log its reference with klog.KObj`
+	klog.InfoS("pod synced", "pod", newPod())    // want `Kubernetes object of type \*Pod is logged in full, log its reference with klog.KObj`
+
+	klog.InfoS("pod synced", "pod", klog.KObj(pod), "node", pod.Spec.NodeName, "meta", pod.ObjectMeta)
+	klog.InfoS("pods synced", "pods", klog.KObjSlice(pods))
+
+	logger := klog.FromContext(context.Background())
+	logger.Info("pod synced", "pod", pod) // want `Kubernetes object of type \*Pod is logged in full, log its reference with klog.KObj`
+}
+
+func newPod() *Pod {
+	return &Pod{}
+}
@@ -0,0 +1,9 @@
+package kobj
+
+import (
+	"github.com/go-logr/logr"
+)
+
+func ExampleLogr(logger logr.Logger, pod *Pod) {
+	logger.Info("pod synced", "pod", pod) // want `Kubernetes object of type \*Pod is logged in full, log its reference with klog.KObj`
+}
@@ -0,0 +1,41 @@
+package kvinmsg
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+type request struct {
+	ID   string
+	Took float64
+}
+
+func ExampleConstant(logger logr.Logger) {
+	logger.Info("retrying attempt=3")   // want `logging message contains key-value text "attempt=3", pass "attempt" as a key-value pair instead`
+	slog.Info("request failed user=%s") // want `logging message contains key-value text "user=%s", pass "user" as a key-value pair instead`
+	zap.S().Infow("done, took:")        // want `logging message contains key-value text "took:", pass "took" as a key-value pair instead`
+
+	logger.Info("Error: connection refused")
+	logger.Info("see https://example.com")
+	logger.Info("user logged in", "user", "bob")
+}
+
+func ExampleSprintf(logger logr.Logger, user string, req request, err error) {
+	logger.Info(fmt.Sprintf("request failed user=%s", user))                  // want `logging message contains key-value text "user=%s"`
+	slog.Info(fmt.Sprintf("request done id=%s took: %.2f", req.ID, req.Took)) // want `key-value text "id=%s"` `key-value text "took: %.2f"`
+	logger.Error(err, fmt.Sprintf("retrying user=%s", user), "attempt", 1)    // want `logging message contains key-value text "user=%s"`
+
+	// No fix: the argument of %d is not the value of a field.
+	logger.Info(fmt.Sprintf("user=%s failed %d times", user, 3)) // want `logging message contains key-value text "user=%s"`
+	logger.Info(fmt.Sprintf("user %s failed", user))
+}
+
+// Loggers without variadic key-value pairs take the values as fields, or as slog.Attr.
+func ExampleFields(ctx context.Context, logger *zap.Logger, user string) {
+	logger.Info(fmt.Sprintf("request failed user=%s", user))                  // want `logging message contains key-value text "user=%s"`
+	slog.LogAttrs(ctx, slog.LevelInfo, fmt.Sprintf("retrying user=%s", user)) // want `logging message contains key-value text "user=%s"`
+}
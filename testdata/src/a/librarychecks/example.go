@@ -0,0 +1,34 @@
+package librarychecks
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	"k8s.io/klog/v2"
+)
+
+// The checks specific to a logger library are only enabled by -librarychecks.
+func ExampleDisabled() {
+	err := errors.New("failed")
+
+	klog.InfoS("retrying", "error", err)
+	klog.InfoS("pod updated", "pod", klog.KRef("default", ""))
+}
+
+func ExampleLogrDisabled(logger logr.Logger) {
+	logger.Error(nil, "retrying")
+	logger.V(-1).Info("message")
+	_ = logger.WithName("my controller")
+	_ = klog.LoggerWithName(logger, "")
+}
+
+func ExampleSlogDisabled(logger *slog.Logger) {
+	slog.Info("message", slog.Group("", "id", 1))
+	_ = logger.WithGroup("")
+}
+
+func ExampleZapDisabled(logger *zap.Logger) {
+	_ = logger.Named("")
+}
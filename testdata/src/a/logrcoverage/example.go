@@ -0,0 +1,57 @@
+package logrcoverage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/go-logr/logr/testr"
+)
+
+func ExampleNames(logger logr.Logger) {
+	logger.WithName("controller").WithName("pod-gc").Info("message")
+	logger.WithName("")            // want `WithName is called with an empty name, it adds an empty element to the logger name`
+	logger.WithName("pod_gc")      // want `logger name "pod_gc" should only contain letters, digits and hyphens`
+	logger.WithName("controller/") // want `logger name "controller/" should only contain letters, digits and hyphens`
+}
+
+func ExampleLevels(logger logr.Logger, level int) {
+	logger.V(0).Info("message")
+	logger.V(-1).Info("message")     // want `V is called with negative level -1, logr treats it as level 0`
+	logger.V(level).Info("message")  // want `V is called with a non-constant level, it cannot be checked against the maximal level 4`
+	logger.V(5).Info("message")      // want `V level 5 exceeds the maximal level 4`
+	logger.V(2).V(3).Info("message") // want `V level 5 exceeds the maximal level 4`
+	logger.V(5).V(1).Info("message") // want `V level 5 exceeds the maximal level 4`
+	logger.V(2).V(2).Info("message")
+	logger.WithName("gc").V(2).WithValues("pod", "x").V(2).Info("message")
+}
+
+func ExampleErrors(logger logr.Logger) {
+	logger.Error(errors.New("failed"), "message", "pod", "x")
+	logger.Error(nil, "message", "pod", "x") // want `Error is called with a nil error, use Info for messages without an error`
+	logger.V(1).Error(nil, "message")        // want `Error is called with a nil error, use Info for messages without an error`
+}
+
+func ExampleBound(ctx context.Context, logger logr.Logger) {
+	logger = logger.WithValues("pod", "x")
+	logger.V(1).Info("message", "pod", "y")             // want `duplicate logging key "pod", it is already bound to the logger`
+	logger.WithName("gc").Info("message", "pod", "y")   // want `duplicate logging key "pod", it is already bound to the logger`
+	logger.WithCallDepth(1).Info("message", "pod", "y") // want `duplicate logging key "pod", it is already bound to the logger`
+
+	ctx = logr.NewContext(ctx, logger)
+	logr.FromContextOrDiscard(ctx).Info("message", "pod", "y") // want `duplicate logging key "pod", it is already bound to the logger`
+	if l, err := logr.FromContext(ctx); err == nil {
+		l.Info("message", "pod", "y")
+	}
+}
+
+func ExampleConstructors(t *testing.T) {
+	_ = funcr.New(func(prefix, args string) {}, funcr.Options{Verbosity: 2})
+	_ = funcr.NewJSON(func(obj string) {}, funcr.Options{Verbosity: -1})          // want `funcr.Options has negative verbosity -1, the logger drops all the info messages`
+	_ = testr.NewWithOptions(t, testr.Options{LogTimestamp: true, Verbosity: -2}) // want `testr.Options has negative verbosity -2, the logger drops all the info messages`
+
+	logger := testr.New(t)
+	logger.Info("message", "key1") // want `odd number of arguments passed as key-value pairs for logging`
+}
@@ -0,0 +1,11 @@
+package logrusdefault
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// The logrus checker is disabled by default, like the kitlog one.
+func ExampleDisabled(name string, n int) {
+	logrus.Warnf("user %s logged in", name, "attempt", n)
+	logrus.Infof("user %d logged in", name)
+}
@@ -0,0 +1,24 @@
+package msgstyle
+
+import (
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"k8s.io/klog/v2"
+)
+
+const lowerMessage = "pod started"
+
+func ExampleMessageStyle(logger logr.Logger, err error) {
+	klog.InfoS("pod started", "pod", "default/nginx")                // want `logging message should start with a capital letter`
+	logger.Error(err, "Failed to start pod.", "pod", "x")            // want `logging message should not end with punctuation`
+	slog.Info("Pod started\nsee details", "pod", "x")                // want `logging message should not contain newlines`
+	logger.Info("", "pod", "x")                                      // want `logging message should not be empty`
+	slog.Warn("Pod was restarted because the liveness probe failed") // want `logging message should be at most 40 characters long`
+	klog.ErrorS(err, "failed!")                                      // want `logging message should start with a capital letter` `logging message should not end with punctuation`
+	klog.InfoS(lowerMessage)                                         // want `logging message should start with a capital letter`
+
+	klog.InfoS("Pod started", "pod", "default/nginx")
+	logger.Info("Waiting for pod...")
+	logger.Info("OK", "key", "value.")
+}
@@ -0,0 +1,59 @@
+package nobadvalues
+
+import (
+	"errors"
+	"log/slog"
+	"net/netip"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+type point struct {
+	x, y int
+}
+
+type config struct {
+	name  string
+	limit int
+}
+
+func (c config) String() string {
+	return c.name
+}
+
+type Public struct {
+	Name string
+	age  int
+}
+
+type state struct {
+	mu sync.Mutex
+}
+
+func (s *state) LogValue() slog.Value {
+	return slog.StringValue("state")
+}
+
+type handler func()
+
+func ExampleBadValues(logger logr.Logger, p point, ptr unsafe.Pointer, ch chan int, h handler) {
+	slog.Info("callback", "func", func() {})                    // want `logging value of type func\(\) serializes badly, functions cannot be serialized`
+	slog.Info("callback", "handler", h)                         // want `logging value of type handler serializes badly, functions cannot be serialized`
+	logger.Info("queue", "ch", ch)                              // want `logging value of type chan int serializes badly, channels cannot be serialized`
+	zap.S().Infow("memory", "ptr", ptr)                         // want `logging value of type unsafe.Pointer serializes badly, unsafe pointers cannot be serialized`
+	slog.Info("point", "point", p)                              // want `logging value of type point serializes badly, the struct has no exported fields, it is serialized as \{\}`
+	slog.Info("point", slog.Any("point", &p))                   // want `logging value of type \*point serializes badly, the struct has no exported fields, it is serialized as \{\}`
+	zap.S().Infow("points", zap.Any("points", map[point]int{})) // want `logging value of type map\[point\]int serializes badly, map keys of type point cannot be serialized`
+	logger.Info("ratios", "ratios", map[float64]string{})       // want `logging value of type map\[float64\]string serializes badly, map keys of type float64 cannot be serialized`
+}
+
+func ExampleGoodValues(logger logr.Logger, cfg config, s *state, public Public) {
+	slog.Info("ok", "config", cfg, "state", s, "public", public, "err", errors.New("x"))
+	slog.Info("ok", "empty", struct{}{}, "time", time.Now(), "any", any(nil))
+	logger.Info("ok", "counts", map[int]int{}, "names", map[string]bool{}, "addrs", map[netip.Addr]int{})
+	zap.S().Infow("ok", zap.String("name", "x"), "values", []int{1})
+}
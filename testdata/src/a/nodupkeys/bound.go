@@ -0,0 +1,119 @@
+package nodupkeys
+
+import (
+	"log/slog"
+
+	kitlog "github.com/go-kit/log"
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+var globalLogger = slog.With("app", "example")
+
+func ExampleBoundKeys(id int, cond bool) {
+	globalLogger.Info("message", "app", "other") // package level loggers are not tracked
+
+	l := slog.With("request_id", id)
+	l.Info("message", "user", 1)
+	l.Info("message", "request_id", id) // want `duplicate logging key "request_id", it is already bound to the logger`
+
+	l2 := l.With("user", 1)
+	l2.Info("message", "request_id", id, "user", 2) // want `duplicate logging key "request_id", it is already bound to the logger` `duplicate logging key "user", it is already bound to the logger`
+	l2.With("user", 3)                              // want `duplicate logging key "user", it is already bound to the logger`
+
+	l3 := l2
+	l3.Info("message", "user", 4) // want `duplicate logging key "user", it is already bound to the logger`
+
+	l3 = slog.Default()
+	l3.Info("message", "user", 4)
+
+	slog.With("trace_id", 1).Info("message", "trace_id", 2) // want `duplicate logging key "trace_id", it is already bound to the logger`
+
+	var log logr.Logger = logr.Discard()
+	if cond {
+		log = log.WithValues("component", "db")
+		log.Info("message", "component", "db") // want `duplicate logging key "component", it is already bound to the logger`
+	} else {
+		log.Info("message", "component", "cache")
+	}
+	log.Info("message", "component", "cache") // bound in one branch only
+
+	log = log.WithValues("component", "db")
+
+	sugar := zap.S().With("user", 1)
+	sugar.Infow("message", "user", 2) // want `duplicate logging key "user", it is already bound to the logger`
+
+	kl := kitlog.With(kitlog.NewNopLogger(), "component", "kit")
+	kl.Log("component", "kit") // want `duplicate logging key "component", it is already bound to the logger`
+}
+
+func ExampleBoundKeysFlow(ids []int, cond bool) {
+	l := slog.With("request_id", 1)
+	if cond {
+		l = slog.Default()
+	} else {
+		l = l.With("user", 1)
+	}
+	l.Info("message", "request_id", 2, "user", 2) // bound in one branch only
+
+	l = slog.With("request_id", 1)
+	if cond {
+		l = l.With("user", 1)
+	} else {
+		l = l.With("user", 2)
+	}
+	l.Info("message", "request_id", 2, "user", 3) // want `duplicate logging key "request_id", it is already bound to the logger` `duplicate logging key "user", it is already bound to the logger`
+
+	l = slog.Default()
+	if !cond {
+		return
+	}
+	l = l.With("user", 1)
+	l.Info("message", "user", 2) // want `duplicate logging key "user", it is already bound to the logger`
+
+	l = slog.Default()
+	for _, id := range ids {
+		l.Info("message", "request_id", id) // bound by the previous iteration only
+		l = slog.With("request_id", id)
+		l.Info("message", "request_id", id) // want `duplicate logging key "request_id", it is already bound to the logger`
+	}
+	l.Info("message", "request_id", 3) // the loop may not run
+
+	l = slog.With("user", 1)
+	for i := 0; i < len(ids); i++ {
+		l.Info("message", "user", i) // want `duplicate logging key "user", it is already bound to the logger`
+	}
+	l.Info("message", "user", 2) // want `duplicate logging key "user", it is already bound to the logger`
+
+	for range ids {
+		l.Info("message", "user", 2) // rebound by a previous iteration
+		if cond {
+			l = slog.Default()
+			continue
+		}
+	}
+	l.Info("message", "user", 3)
+
+	l = slog.Default()
+	for {
+		l = l.With("user", 1)
+		break
+	}
+	l.Info("message", "user", 2) // want `duplicate logging key "user", it is already bound to the logger`
+
+	l = slog.Default()
+	switch {
+	case cond:
+		l = l.With("user", 1)
+	default:
+		l = l.With("user", 2)
+	}
+	l.Info("message", "user", 3) // want `duplicate logging key "user", it is already bound to the logger`
+
+	l = slog.Default()
+	func() {
+		l = l.With("user", 1)
+		l.Info("message", "user", 2) // want `duplicate logging key "user", it is already bound to the logger`
+	}()
+	l.Info("message", "user", 3) // the function literal may run at any time
+}
@@ -0,0 +1,31 @@
+package nodupkeys
+
+import (
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+const KeyUser = "user"
+
+func ExampleDupKeys() {
+	log := logr.Discard()
+
+	log.Info("message", "user", 1, "id", 2)
+	log.Info("message", "user", 1, "user", 2)  // want `duplicate logging key "user"`
+	log.Info("message", "user", 1, KeyUser, 2) // want `duplicate logging key "user"`
+	log.Info("message", "user", 1, "User", 2)
+	log.Info("message", "user_id", 1, "userID", 2)
+	log.WithValues("a", 1, "b", 2, "a", 3, "a", 4) // want `duplicate logging key "a"` `duplicate logging key "a"`
+
+	// Keys bound by With-like calls are compared as well.
+	log.WithValues("user", 1).Info("message", "user", 2) // want `duplicate logging key "user", it is already bound to the logger`
+	log.WithValues("user", 1).Info("message", "id", 2)
+
+	slog.Info("message", "user", 1, slog.Group("user", "user", 2))    // want `duplicate logging key "user"`
+	slog.Info("message", "user", 1, slog.Group("request", "user", 2)) // nested in the group
+	slog.Info("message", "user", 1, "user", 2)                        // want `duplicate logging key "user"`
+
+	zap.S().Infow("message", "user", 1, zap.String("user", "x"), "user", 2) // want `duplicate logging key "user"` `duplicate logging key "user"`
+}
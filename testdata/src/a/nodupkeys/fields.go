@@ -0,0 +1,65 @@
+package nodupkeys
+
+import (
+	"log/slog"
+
+	"github.com/go-logr/logr"
+
+	"a/nodupkeys/service"
+)
+
+type Store struct {
+	log    logr.Logger
+	slog   *slog.Logger
+	plain  logr.Logger
+	mixed  logr.Logger
+	status string
+}
+
+func (s *Store) Get() {
+	// Fields may be assigned after they are used in the source.
+	s.log.Info("message", "component", "other") // want `duplicate logging key "component", it is already bound to the logger`
+	s.log.Info("message", "key", "value")
+	s.plain.Info("message", "component", "other")
+	s.mixed.Info("message", "component", "other", "kind", "x") // want `duplicate logging key "component", it is already bound to the logger`
+	s.slog.With("kind", "y").Info("message")                   // want `duplicate logging key "kind", it is already bound to the logger`
+}
+
+func NewStore(logger logr.Logger) *Store {
+	log := logger.WithValues("component", "db")
+	s := &Store{
+		log:    log,
+		plain:  logger,
+		mixed:  logger.WithValues("component", "db", "kind", "a"),
+		status: "ok",
+	}
+	s.slog = slog.With("component", "db", "kind", "sql")
+	return s
+}
+
+func (s *Store) Reset(logger logr.Logger) {
+	s.mixed = logger.WithValues("component", "db")
+}
+
+func ExampleOtherPackage(logger logr.Logger) {
+	svc := service.NewService(logger)
+	svc.Log.Info("message", "component", "other") // want `duplicate logging key "component", it is already bound to the logger`
+}
+
+type Worker struct {
+	derived *slog.Logger
+	base    *slog.Logger
+}
+
+func (w *Worker) Run() {
+	// Fields assigned from other fields do not depend on the order of the declarations.
+	w.derived.Info("message", "component", "other", "worker", 2) // want `duplicate logging key "component", it is already bound to the logger` `duplicate logging key "worker", it is already bound to the logger`
+}
+
+func (w *Worker) initDerived() {
+	w.derived = w.base.With("worker", 1)
+}
+
+func (w *Worker) initBase() {
+	w.base = slog.With("component", "worker")
+}
@@ -0,0 +1,15 @@
+package fold
+
+import (
+	"github.com/go-logr/logr"
+)
+
+func ExampleFoldKeys() {
+	log := logr.Discard()
+
+	log.Info("message", "user", 1, "id", 2)
+	log.Info("message", "user", 1, "user", 2)                 // want `duplicate logging key "user"`
+	log.Info("message", "user", 1, "User", 2)                 // want `logging key "User" duplicates "user", they only differ in case or separator`
+	log.Info("message", "user_id", 1, "userID", 2)            // want `logging key "userID" duplicates "user_id", they only differ in case or separator`
+	log.Info("message", "user-id", 1, "user.id", 2, "uid", 3) // want `logging key "user.id" duplicates "user-id", they only differ in case or separator`
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"github.com/go-logr/logr"
+)
+
+type Service struct {
+	Log logr.Logger
+}
+
+func NewService(logger logr.Logger) *Service {
+	return &Service{
+		Log: logger.WithValues("component", "service"),
+	}
+}
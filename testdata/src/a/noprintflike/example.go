@@ -22,8 +22,9 @@ func ExamplePrintfLike() {
 	log.Info("%#[1].3")
 	log.Info("%[3]*.[2*[1]f", "intKey", 1)
 
-	// Check message and key value pairs
-	log.Info("message", "key%s", "value %d") // want `logging message should not use format specifier "%s"`
+	// Only the message is checked
+	log.Info("message", "key%s", "value %d")
+	log.Info("progress", "ratio", "50%d")
 
 	log.Info("%[3]*s x") // want `logging message should not use format specifier ".+"`
 	log.Info("%[3]d x")  // want `logging message should not use format specifier ".+"`
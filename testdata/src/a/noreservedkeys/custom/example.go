@@ -0,0 +1,13 @@
+package custom
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func ExampleCustomReservedKeys() {
+	slog.Info("message", "msg", "value", "message", "value") // want `logging key "message" collides with the key reserved by the logger`
+
+	zap.S().Infow("message", "ts", 1, "time", 2, "M", "x") // want `logging key "time" collides with the key reserved by the logger` `logging key "M" collides with the key reserved by the logger`
+}
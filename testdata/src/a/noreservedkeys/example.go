@@ -0,0 +1,57 @@
+package noreservedkeys
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	kitlog "github.com/go-kit/log"
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	"k8s.io/klog/v2"
+)
+
+func ExampleReservedKeys() {
+	err := errors.New("example error")
+
+	slog.Info("message", "msg", "value")                                // want `logging key "msg" collides with the key reserved by the logger`
+	slog.With("time", 1, "level", 2).Info("message")                    // want `logging key "time" collides with the key reserved by the logger` `logging key "level" collides with the key reserved by the logger`
+	slog.InfoContext(context.TODO(), "message", "source", "x", "ts", 1) // want `logging key "source" collides with the key reserved by the logger`
+
+	zap.S().Infow("message", "ts", 1, "time", 2, "caller", "x") // want `logging key "ts" collides with the key reserved by the logger` `logging key "caller" collides with the key reserved by the logger`
+	zap.S().With("stacktrace", "x").Infow("message")            // want `logging key "stacktrace" collides with the key reserved by the logger`
+
+	klog.InfoS("message", "err", err)
+	klog.ErrorS(err, "message", "err", err)      // want `logging key "err" collides with the key reserved by the logger`
+	klog.V(1).ErrorS(err, "message", "err", err) // want `logging key "err" collides with the key reserved by the logger`
+
+	log := logr.Discard()
+	log.Info("message", "error", err)
+	log.Error(err, "message", "error", err) // want `logging key "error" collides with the key reserved by the logger`
+	log.Error(err, "message", "err", err, "msg", "x")
+
+	kitlog.NewNopLogger().Log("msg", "message", "ts", 1)
+}
+
+func ExampleReservedFieldKeys(logger *zap.Logger) {
+	logger.Info("message", zap.String("ts", "x"), zap.Int("user", 1)) // want `logging key "ts" collides with the key reserved by the logger`
+	logger.Info("message", zap.Namespace("request"), zap.String("msg", "x"))
+	logger.With(zap.Namespace("caller")).Info("message") // want `logging key "caller" collides with the key reserved by the logger`
+
+	slog.Info("message", slog.String("time", "x"))                                          // want `logging key "time" collides with the key reserved by the logger`
+	slog.LogAttrs(context.TODO(), slog.LevelInfo, "message", slog.Int("level", 1))          // want `logging key "level" collides with the key reserved by the logger`
+	slog.LogAttrs(context.TODO(), slog.LevelInfo, "message", slog.Group("req", "msg", "x")) // grouped keys do not collide
+}
+
+func ExampleReservedGroupedKeys() {
+	slog.Group("req", "msg", "x", "time", 1)
+	slog.Info("message", slog.Group("req", slog.String("level", "x")))
+	slog.Default().WithGroup("req").Info("message", "msg", "x")
+
+	grouped := slog.Default().WithGroup("req").With("user", 1)
+	grouped.Info("message", "time", 1)
+	grouped.With("source", "x").Info("message")
+
+	plain := slog.Default().With("user", 1)
+	plain.Info("message", "time", 1) // want `logging key "time" collides with the key reserved by the logger`
+}
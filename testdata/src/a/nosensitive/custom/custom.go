@@ -0,0 +1,29 @@
+package custom
+
+import (
+	"log/slog"
+)
+
+func ExampleCustomSensitiveKeys(email, password string) {
+	slog.Info("signup", "customer_email", email) // want `logging key "customer_email" looks sensitive, it matches "email"`
+	slog.Info("signup", "password", password)
+}
+
+type Token string
+
+type Envelope struct {
+	ID    int
+	Token Token
+}
+
+type Request struct {
+	Path     string
+	Envelope *Envelope
+}
+
+func ExampleCustomSensitiveTypes(token Token, envelope Envelope, request Request) {
+	slog.Info("request", "auth", token)        // want "logging value of type Token is sensitive, the type a/nosensitive/custom.Token is listed as sensitive"
+	slog.Info("request", "envelope", envelope) // want "logging value of type Envelope is sensitive, field Token has type a/nosensitive/custom.Token, which is listed as sensitive"
+	slog.Info("request", "request", request)   // want "logging value of type Request is sensitive, field Envelope.Token has type a/nosensitive/custom.Token, which is listed as sensitive"
+	slog.Info("request", "raw", string(token))
+}
@@ -0,0 +1,78 @@
+package nosensitive
+
+import (
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type Credentials struct {
+	User     string
+	Password string `log:"redact"`
+}
+
+type Customer struct {
+	Name string
+	SSN  string `json:"ssn" sensitive:"true"`
+}
+
+type Order struct {
+	ID       int
+	Customer *Customer
+}
+
+type Account struct {
+	Name        string
+	Credentials Credentials
+}
+
+func (a Account) LogValue() slog.Value {
+	return slog.StringValue(a.Name)
+}
+
+type Session struct {
+	Token string `log:"redact"`
+}
+
+func (s *Session) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("token", "***")
+	return nil
+}
+
+type Token struct {
+	Value string `log:"redact"`
+}
+
+func (t Token) MarshalLog() interface{} {
+	return "***"
+}
+
+// Login redacts its token only if the token is logged itself, encoders do not call MarshalLog on nested fields.
+type Login struct {
+	Token    Token
+	Password string `log:"redact"`
+}
+
+type Public struct {
+	Name string `log:"name" sensitive:"false"`
+}
+
+func ExampleSensitiveTypes(logger logr.Logger, creds Credentials, order Order, customers []Customer, account Account, session *Session, token Token) {
+	slog.Info("login", "user", creds)                  // want "logging value of type Credentials is sensitive, field Password is tagged `log:\"redact\"`: redact it with a LogValue, MarshalLogObject or MarshalLog method"
+	slog.Info("login", slog.Any("user", &creds))       // want "logging value of type \\*Credentials is sensitive, field Password is tagged `log:\"redact\"`"
+	logger.Info("order", "order", order)               // want "logging value of type Order is sensitive, field Customer.SSN is tagged `sensitive:\"true\"`"
+	zap.S().Infow("customers", "customers", customers) // want "logging value of type \\[\\]Customer is sensitive, field SSN is tagged `sensitive:\"true\"`"
+
+	slog.Info("login", "account", account)
+	zap.S().Infow("login", "session", session)
+	logger.Info("login", "api", token)
+	slog.Info("ok", "public", Public{}, "name", creds.User)
+}
+
+func ExampleNestedRedaction(login Login, tokens []Token) {
+	slog.Info("login", "login", login)         // want "logging value of type Login is sensitive, field Token.Value is tagged `log:\"redact\"`"
+	slog.Info("login", "tokens", tokens)       // want "logging value of type \\[\\]Token is sensitive, field Value is tagged `log:\"redact\"`"
+	slog.Info("login", "session", login.Token) // redacted by its MarshalLog method
+}
@@ -0,0 +1,56 @@
+package nostringvalues
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+type status int
+
+func (s status) String() string {
+	return "ok"
+}
+
+func ExampleKeyValues(logger logr.Logger, err error, n int, t time.Time, s status) {
+	logger.Info("failed", "err", err.Error())             // want `err.Error\(\) converts the error to a string, pass err instead`
+	slog.Info("counted", "count", fmt.Sprintf("%d", n))   // want `fmt.Sprintf\("%d", n\) converts the value to a string, pass n instead`
+	zap.S().Infow("scheduled", "when", t.String())        // want `t.String\(\) converts the value to a string, pass t instead`
+	slog.Info("failed", "err", fmt.Sprintf("%v", err))    // want `fmt.Sprintf\("%v",\.\.\. converts the error to a string, pass err instead`
+	logger.Info("status", "status", (s).String(), "n", n) // want `\(s\).String\(\) converts the value to a string, pass s instead`
+	logger.Info("ok", "count", fmt.Sprintf("%03d", n), "pair", fmt.Sprintf("%d/%d", n, n))
+	logger.Info("ok", "msg", err.Error(), fmt.Sprint(n), "v") // want `err.Error\(\) converts the error to a string, pass err instead`
+}
+
+func ExampleZapFields(err error, t time.Time, n int) {
+	sugar := zap.S()
+	sugar.Infow("failed", zap.Any("error", err))                  // want `zap.Any is used with an error, use zap.Error instead`
+	sugar.Infow("failed", zap.Any("cause", err))                  // want `zap.Any is used with an error, use zap.NamedError instead`
+	sugar.Infow("failed", zap.String("error", err.Error()))       // want `err.Error\(\) converts the error to a string, use zap.Error instead`
+	sugar.Infow("scheduled", zap.String("when", t.String()))      // want `t.String\(\) converts the value to a string, use zap.Stringer instead`
+	sugar.Infow("counted", zap.String("n", fmt.Sprintf("%d", n))) // want `fmt.Sprintf\("%d", n\) converts the value to a string, use zap.Any instead`
+
+	sugar.Infow("ok", zap.Any("n", n), zap.Error(err), zap.String("s", "x"))
+}
+
+func ExampleSlogAttrs(err error, t time.Time) {
+	slog.Info("failed", slog.String("err", err.Error()))    // want `err.Error\(\) converts the error to a string, use slog.Any instead`
+	slog.Info("scheduled", slog.String("when", t.String())) // want `t.String\(\) converts the value to a string, use slog.Any instead`
+
+	slog.Info("ok", slog.Any("err", err), slog.String("s", "x"))
+}
+
+// ExampleBuffers logs buffers, String has a pointer receiver so the values themselves would be logged as structs.
+func ExampleBuffers(buf bytes.Buffer, sb strings.Builder, bufp *bytes.Buffer) {
+	slog.Info("read", "body", buf.String(), "text", sb.String())
+	zap.S().Infow("read", zap.String("body", buf.String()))
+	slog.Info("read", slog.String("body", buf.String()))
+
+	slog.Info("read", "body", bufp.String())                 // want `bufp.String\(\) converts the value to a string, pass bufp instead`
+	zap.S().Infow("read", zap.String("body", bufp.String())) // want `bufp.String\(\) converts the value to a string, use zap.Stringer instead`
+}
@@ -0,0 +1,31 @@
+package noswappedkv
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+func ExampleSwappedKV() {
+	log := logr.Discard()
+
+	userID := 42
+	var anyValue interface{} = "user"
+	var err error
+	var stringer fmt.Stringer
+
+	log.Info("message", "user", userID)
+	log.Info("message", "user", "id", "count", userID)
+	log.Info("message", anyValue, "value")
+	log.Info("message", err, "value")      // want `key-value pair seems swapped, err is passed as a key but "value" looks like the key`
+	log.Info("message", stringer, "value") // want `key-value pair seems swapped, stringer is passed as a key but "value" looks like the key`
+
+	log.Info("message", userID, "user")                   // want `key-value pair seems swapped, userID is passed as a key but "user" looks like the key`
+	log.Info("message", "user", "id", userID, "count")    // want `key-value pairs seem shifted, "id" is passed as a value but looks like a key`
+	log.Info("message", "user", "id", userID, "count", 1) // want `odd number of arguments passed as key-value pairs for logging` `key-value pairs seem shifted, "id" is passed as a value but looks like a key`
+
+	slog.Info("message", slog.Int("user", userID), userID, "user") // want `key-value pair seems swapped, userID is passed as a key but "user" looks like the key`
+	zap.S().Infow("message", zap.Int("user", userID), "user", userID)
+}
@@ -0,0 +1,52 @@
+package printfcalls
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+	"k8s.io/klog/v2"
+)
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func (u user) String() string {
+	return u.Name
+}
+
+type point struct {
+	X, Y int
+}
+
+func ExampleCounts(sugar *zap.SugaredLogger, name string, n int) {
+	klog.Infof("user %s logged in %d times", name)        // want `Infof format %d reads arg #2, but call has 1 args`
+	sugar.Errorf("user %s logged in", name, n)            // want `Errorf call needs 1 args but has 2 args`
+	logrus.Warnf("user %s logged in", name, "attempt", n) // want `arguments of Warnf look like key-value pairs, but they are extra arguments of the format`
+	klog.V(2).Infof("user %s logged in %d times", name, n)
+	klog.InfofDepth(1, "user %s logged in %d times, 100%%", name, n)
+}
+
+func ExampleTypes(logger *logrus.Logger, entry *logrus.Entry, u user, p point, n int, err error) {
+	logger.Infof("user %d", u.Name)             // want `Infof format %d has arg u.Name of wrong type string`
+	entry.Debugf("age %s", u.Age)               // want `Debugf format %s has arg u.Age of wrong type int`
+	klog.Errorf("ratio %.2f, done %t", n, true) // want `Errorf format %.2f has arg n of wrong type int`
+	logger.Infof("point %s", p)                 // want `Infof format %s has arg p of wrong type point`
+
+	logger.Infof("user %s, point %d, %v", u, &p, p)
+	logger.Infof("error %s, %x", err, []byte("x"))
+	entry.Infof("type %T, pointer %p", u, &u)
+}
+
+func ExampleWrap(sugar *zap.SugaredLogger, err error) {
+	sugar.Errorf("request failed: %w", err)                    // want `Errorf does not support error-wrapping directive %w, use %v instead`
+	klog.Errorf("failed %w, retry: %+w", err, errors.New("x")) // want `Errorf does not support error-wrapping directive %w` `Errorf does not support error-wrapping directive %\+w`
+}
+
+func ExampleInvalid(format string, name string) {
+	klog.Infof("user %z", name) // want `Infof format %z has unknown verb or flags`
+	klog.Infof(format, name)
+	klog.Infof("user %[1]s %[1]q", name)
+}
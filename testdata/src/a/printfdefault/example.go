@@ -0,0 +1,13 @@
+package printfdefault
+
+import (
+	"go.uber.org/zap"
+	"k8s.io/klog/v2"
+)
+
+// The arguments of printf-like functions are only checked against their format with -printfargs.
+func ExampleDisabled(name string) {
+	klog.Infof("user %d logged in", name)
+	zap.S().Infof("user %s logged in %d times", name)
+	zap.S().Errorf("request failed: %w", nil)
+}
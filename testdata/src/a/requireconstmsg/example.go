@@ -0,0 +1,42 @@
+package requireconstmsg
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+type user struct {
+	Name string
+}
+
+const prefix = "user "
+
+func ExampleSprintf(logger logr.Logger, u user, id int, err error) {
+	logger.Info(fmt.Sprintf("user %s failed", u.Name))                 // want `logging message should be a constant string, it is formatted with fmt.Sprintf: pass the variable parts as key-value pairs instead`
+	slog.Info(fmt.Sprintf("request %d: %v", id, err), "attempt", 1)    // want `logging message should be a constant string, it is formatted with fmt.Sprintf`
+	zap.S().Infow(fmt.Sprintf("loaded %d%%", id))                      // want `logging message should be a constant string, it is formatted with fmt.Sprintf`
+	logger.Error(err, fmt.Sprintf("user %s failed", u.FullName()+"x")) // want `logging message should be a constant string, it is formatted with fmt.Sprintf`
+	logger.Info(fmt.Sprintf("%s", u.Name))                             // want `logging message should be a constant string, it is formatted with fmt.Sprintf`
+}
+
+func (u user) FullName() string {
+	return u.Name
+}
+
+func ExampleConcat(logger logr.Logger, name string, u user) {
+	logger.Info("user " + name + " failed")   // want `logging message should be a constant string, it is built by concatenation: pass the variable parts as key-value pairs instead`
+	slog.Info(prefix+name, "attempt", 1)      // want `logging message should be a constant string, it is built by concatenation`
+	slog.Warn("user " + name + " of " + name) // want `logging message should be a constant string, it is built by concatenation`
+}
+
+func ExampleOther(logger logr.Logger, msg string, u user) {
+	logger.Info(msg)  // want `logging message should be a constant string$`
+	slog.Info(u.Name) // want `logging message should be a constant string$`
+
+	logger.Info("constant", "user", u.Name)
+	logger.Info(prefix + "constant")
+	slog.Info(prefix)
+}
@@ -0,0 +1,14 @@
+package requireconstmsg
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func ExampleFields(ctx context.Context, logger *zap.Logger, id int) {
+	logger.Info(fmt.Sprintf("loaded %d", id), zap.Int("attempt", 1)) // want `logging message should be a constant string, it is formatted with fmt.Sprintf`
+	slog.LogAttrs(ctx, slog.LevelInfo, fmt.Sprintf("loaded %d", id)) // want `logging message should be a constant string, it is formatted with fmt.Sprintf`
+}
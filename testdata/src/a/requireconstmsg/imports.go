@@ -0,0 +1,10 @@
+package requireconstmsg
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+func ExampleImports(id int) {
+	slog.Info(fmt.Sprintf("loaded %d", id)) // want `logging message should be a constant string, it is formatted with fmt.Sprintf`
+}
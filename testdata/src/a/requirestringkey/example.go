@@ -39,6 +39,14 @@ func ExampleRequireStringKey() {
 	log.Error(err, "message", OtherFileKey1Str, "value1")
 	log.Error(err, "message", otherpkg.KeyStr, "value1")
 
+	type LogKey string
+	const KeyTyped LogKey = "key1"
+	log.Error(err, "message", KeyTyped, "value1")
+	log.Error(err, "message", otherpkg.KeyTyped, "value1")
+	log.Error(err, "message", otherpkg.KeyVar, "value1") // want `logging keys are expected to be inlined constant strings, please replace "otherpkg.KeyVar" provided with string`
+	const KeyTypedNonASCII LogKey = "键1"
+	log.Error(err, "message", KeyTypedNonASCII, "value1") // want `logging keys are expected to be alphanumeric strings, please remove any non-latin characters from "键1"`
+
 	log.Error(err, "message", "键1", "value1") // want `logging keys are expected to be alphanumeric strings, please remove any non-latin characters from "键1"`
 	const KeyNonASCII = "键1"
 	log.Error(err, "message", KeyNonASCII, "value1") // want `logging keys are expected to be alphanumeric strings, please remove any non-latin characters from "键1"`
@@ -1,3 +1,9 @@
 package otherpkg
 
+type LogKey string
+
 const KeyStr = "key"
+
+const KeyTyped LogKey = "typed"
+
+var KeyVar = "var"
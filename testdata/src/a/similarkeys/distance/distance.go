@@ -0,0 +1,12 @@
+package distance
+
+import (
+	"log/slog"
+)
+
+func ExampleDistanceOff(status string) {
+	slog.Info("done", "status", status)
+	slog.Info("done", "status", status)
+	slog.Info("done", "statu", status)
+	slog.Info("done", "Status", status) // want `logging key "Status" is similar to the more common key "status"`
+}
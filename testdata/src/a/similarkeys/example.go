@@ -0,0 +1,29 @@
+package similarkeys
+
+import (
+	"log/slog"
+
+	"a/similarkeys/users"
+)
+
+func ExampleSimilarKeys(id string) {
+	users.Create(id)
+
+	slog.Info("login", "userId", id)  // want `logging key "userId" is similar to the more common key "user_id", used in a/similarkeys/users`
+	slog.Info("logout", "userID", id) // want `logging key "userID" is similar to the more common key "user_id", used in a/similarkeys/users`
+	slog.Info("login", "uid", id)
+	slog.Info("ok", "reason", "done")
+}
+
+func ExampleLocalKeys(host string) {
+	slog.Info("connected", "host_name", host) // want `logging key "host_name" is similar to the more common key "hostName"`
+	slog.Info("connected", "hostName", host)
+	slog.Info("closed", "hostName", host)
+	slog.Info("retry", "requestId", "x")
+	slog.Info("retry", "request_id", "y") // want `logging key "request_id" is similar to the more common key "requestId"`
+}
+
+func ExampleTypos() {
+	slog.Info("expired", "reasons", "timeout") // want `logging key "reasons" is similar to the more common key "reason", used in a/similarkeys/users`
+	slog.Info("cache", "id", 1, "ip", "127.0.0.1")
+}
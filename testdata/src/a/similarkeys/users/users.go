@@ -0,0 +1,17 @@
+package users
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func Create(id string) {
+	slog.Info("user created", "user_id", id)
+	zap.S().Infow("user created", "user_id", id)
+}
+
+func Delete(id string) {
+	slog.Info("user deleted", "user_id", id, "reason", "expired")
+	zap.S().Infow("user deleted", zap.String("user_id", id))
+}
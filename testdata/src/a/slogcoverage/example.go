@@ -0,0 +1,51 @@
+package slogcoverage
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+func ExampleLog(ctx context.Context, logger *slog.Logger) {
+	slog.Log(ctx, slog.LevelInfo, "message", "key")                                            // want `odd number of arguments passed as key-value pairs for logging`
+	logger.Log(ctx, slog.LevelInfo, "message", "key", 1, "key", 2)                             // want `duplicate logging key "key"`
+	slog.LogAttrs(ctx, slog.LevelInfo, "message", slog.String("password", "x"))                // want `logging key "password" looks sensitive`
+	logger.LogAttrs(ctx, slog.LevelInfo, "message", slog.Int("id", 1), slog.Any("token", nil)) // want `logging key "token" looks sensitive`
+
+	slog.Log(ctx, slog.LevelInfo, "message", "key", 1)
+	logger.LogAttrs(ctx, slog.LevelInfo, "message", slog.Int("key", 1))
+}
+
+func ExampleRecord() {
+	r := slog.NewRecord(time.Now(), slog.LevelInfo, "message", 0)
+	r.Add("key")                            // want `odd number of arguments passed as key-value pairs for logging`
+	r.Add("key", 1, "key", 2)               // want `duplicate logging key "key"`
+	r.AddAttrs(slog.String("password", "")) // want `logging key "password" looks sensitive`
+
+	r.Add("key", 1, slog.Int("other", 2))
+	r.AddAttrs(slog.String("key", "a"), slog.Int("other", 2))
+}
+
+func ExampleGroups(logger *slog.Logger, h slog.Handler) {
+	slog.Info("message", slog.Group("request", "key"))                                // want `odd number of arguments passed as key-value pairs for logging`
+	slog.Info("message", slog.Group("request", "id", 1, "id", 2))                     // want `duplicate logging key "id"`
+	slog.Info("message", slog.Group("request", slog.Group("user", "password", "x")))  // want `logging key "password" looks sensitive`
+	_ = slog.GroupValue(slog.Int("id", 1), slog.String("password", "x"))              // want `logging key "password" looks sensitive`
+	_ = slog.GroupValue(slog.Any("user", slog.GroupValue(slog.String("token", "x")))) // want `logging key "token" looks sensitive`
+
+	slog.Info("message", slog.Group("", "id", 1))                // want `Group is called with an empty group name, the attributes are inlined into the parent group`
+	slog.Info("message", slog.GroupAttrs("", slog.Int("id", 1))) // want `GroupAttrs is called with an empty group name`
+	_ = logger.WithGroup("")                                     // want `WithGroup is called with an empty group name, the receiver is returned unchanged`
+	_ = h.WithGroup("")                                          // want `WithGroup is called with an empty group name`
+
+	slog.Info("message", "id", 1, slog.Group("request", "id", 2))
+	logger.With("id", 1).WithGroup("request").Info("message", "id", 2)
+}
+
+func ExampleHandler(h slog.Handler, jh *slog.JSONHandler) {
+	_ = h.WithAttrs([]slog.Attr{slog.Int("id", 1), slog.String("password", "x")}) // want `logging key "password" looks sensitive`
+	_ = jh.WithAttrs([]slog.Attr{slog.String("token", "x")})                      // want `logging key "token" looks sensitive`
+
+	attrs := []slog.Attr{slog.Int("id", 1)}
+	_ = h.WithAttrs(attrs)
+}
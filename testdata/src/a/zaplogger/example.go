@@ -0,0 +1,45 @@
+package zaplogger
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func ExampleKeys(logger *zap.Logger, key string) {
+	logger.Info("message", zap.String(key, "value"))          // want `logging keys are expected to be inlined constant strings, please replace "key" provided with string`
+	logger.Warn("message", zap.Int("ключ", 1))                // want `logging keys are expected to be alphanumeric strings, please remove any non-latin characters from "ключ"`
+	logger.Log(zapcore.InfoLevel, "message", zap.Any(key, 1)) // want `logging keys are expected to be inlined constant strings`
+	logger.With(zap.Namespace(key))                           // want `logging keys are expected to be inlined constant strings`
+
+	logger.Info("message", zap.String("key", "value"), zap.Error(nil))
+}
+
+func ExampleDuplicates(logger *zap.Logger) {
+	logger.Info("message", zap.String("id", "a"), zap.Int("id", 1))                           // want `duplicate logging key "id"`
+	logger.Error("message", zap.String("id", "a"), zap.Namespace("id"))                       // want `duplicate logging key "id"`
+	logger.Info("message", zap.String("id", "a"), zap.Namespace("request"), zap.Int("id", 1)) // nested in the namespace
+
+	if ce := logger.Check(zapcore.InfoLevel, "message"); ce != nil {
+		ce.Write(zap.String("id", "a"), zap.Int("id", 1)) // want `duplicate logging key "id"`
+	}
+}
+
+func ExampleBound(logger *zap.Logger) {
+	l := logger.With(zap.String("component", "db"))
+	l.Info("message", zap.String("component", "cache"))                           // want `duplicate logging key "component", it is already bound to the logger`
+	l.Named("child").Info("message", zap.String("component", "cache"))            // want `duplicate logging key "component", it is already bound to the logger`
+	logger.WithLazy(zap.Int("attempt", 1)).Info("message", zap.Int("attempt", 2)) // want `duplicate logging key "attempt", it is already bound to the logger`
+
+	nested := l.With(zap.Namespace("request"))
+	nested.Info("message", zap.String("component", "cache"))
+
+	_ = logger.Named("") // want `Named is called with an empty name, the logger is returned unchanged`
+}
+
+func ExampleSugar(sugar *zap.SugaredLogger) {
+	sugar.Logw(zapcore.InfoLevel, "message", "id", 1, "id", 2)         // want `duplicate logging key "id"`
+	sugar.WithLazy("attempt", 1).Infow("message", "attempt", 2)        // want `duplicate logging key "attempt", it is already bound to the logger`
+	sugar.Log(zapcore.InfoLevel, "user logged in", "user", sugar)      // want `arguments of Log look like key-value pairs, but they are formatted: use Logw instead`
+	sugar.Logf(zapcore.InfoLevel, "user logged in", "user", sugar)     // want `arguments of Logf look like key-value pairs, but they are extra arguments of the format: use Logw instead`
+	sugar.Logf(zapcore.InfoLevel, "user %s logged in %d times", "bob") // want `Logf format %d reads arg #2, but call has 1 args`
+}
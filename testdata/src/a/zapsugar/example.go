@@ -0,0 +1,28 @@
+package zapsugar
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+func ExamplePrint(sugar *zap.SugaredLogger, user string, err error) {
+	sugar.Info("user logged in", "user", user)   // want `arguments of Info look like key-value pairs, but they are formatted: use Infow instead`
+	sugar.Errorln("failed", "error", err)        // want `arguments of Errorln look like key-value pairs, but they are formatted: use Errorw instead`
+	zap.S().Debug("request", "id", 1, "took", 2) // want `arguments of Debug look like key-value pairs, but they are formatted: use Debugw instead`
+
+	sugar.Info("user ", user, " logged in")
+	sugar.Info("user", user)
+	sugar.Info(err, "user", user)
+	sugar.Info("one", "two", "three")
+	sugar.Infow("user logged in", "user", user)
+}
+
+func ExamplePrintf(sugar *zap.SugaredLogger, user string, err error) {
+	sugar.Infof("user logged in", "user", user)          // want `arguments of Infof look like key-value pairs, but they are extra arguments of the format: use Infow instead`
+	sugar.Warnf("user %s logged in", user, "attempt", 3) // want `arguments of Warnf look like key-value pairs, but they are extra arguments of the format: use Warnw instead`
+
+	sugar.Infof("user %s logged in", user)
+	sugar.Infof("user %s: %v", user, errors.New("x"))
+	sugar.Infof("%s=%d", "attempt", 3)
+}